@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// attributeActionable is whether the event is actionable, as opposed to informational.
+const attributeActionable = "k8s.event.actionable"
+
+// actionable reports whether the event is actionable: its reason is actionable, or its type is
+// unless its reason is informational. The informational reasons take precedence.
+func (cfg *ActionableConfig) actionable(ev *corev1.Event) bool {
+	if slices.Contains(cfg.InformationalReasons, ev.Reason) {
+		return false
+	}
+	if slices.Contains(cfg.Reasons, ev.Reason) {
+		return true
+	}
+	return slices.ContainsFunc(cfg.Types, func(typ string) bool {
+		return strings.EqualFold(typ, ev.Type)
+	})
+}
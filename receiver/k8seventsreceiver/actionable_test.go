@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestK8sEventToLogDataWithActionable(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      func(*ActionableConfig)
+		reason   string
+		typ      string
+		expected bool
+	}{
+		{name: "failed_scheduling", reason: "FailedScheduling", typ: "Warning", expected: true},
+		{name: "pulled", reason: "Pulled", typ: "Normal"},
+		{name: "warning", reason: "BackOff", typ: "warning", expected: true},
+		{name: "informational_warning", reason: "ProbeWarning", typ: "Warning"},
+		{name: "actionable_reason_of_normal_event", reason: "Evicted", typ: "Normal", expected: true},
+		{
+			name: "overridden_informational_reasons",
+			cfg: func(cfg *ActionableConfig) {
+				cfg.InformationalReasons = []string{"BackOff"}
+			},
+			reason: "BackOff",
+			typ:    "Warning",
+		},
+		{
+			name: "overridden_reasons",
+			cfg: func(cfg *ActionableConfig) {
+				cfg.Types = nil
+				cfg.Reasons = []string{"Pulled"}
+			},
+			reason:   "Pulled",
+			typ:      "Normal",
+			expected: true,
+		},
+		{
+			name: "overridden_types",
+			cfg: func(cfg *ActionableConfig) {
+				cfg.Types = nil
+			},
+			reason: "BackOff",
+			typ:    "Warning",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Actionable.Enabled = true
+			if tt.cfg != nil {
+				tt.cfg(&cfg.Actionable)
+			}
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.Type = tt.typ
+			lr := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			actionable, ok := lr.Attributes().Get(attributeActionable)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, actionable.Bool())
+		})
+	}
+}
+
+func TestK8sEventToLogDataWithoutActionable(t *testing.T) {
+	lr := k8sEventToLogData(zap.NewNop(), getEvent(), createDefaultConfig().(*Config), nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, ok := lr.Attributes().Get(attributeActionable)
+	assert.False(t, ok)
+}
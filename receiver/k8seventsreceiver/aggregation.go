@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// attributeAggregationCount is the number of identical events aggregated across the namespaces.
+	attributeAggregationCount = "k8s.event.aggregation.count"
+
+	// attributeAggregationNamespaces are the namespaces the aggregated events occurred in.
+	attributeAggregationNamespaces = "k8s.event.aggregation.namespaces"
+
+	// attributeAggregationWindowStart is the start of the window of an aggregation.
+	attributeAggregationWindowStart = "k8s.event.aggregation.window_start"
+)
+
+// aggregationKey identifies the identical events aggregated together.
+type aggregationKey struct {
+	reason  string
+	message string
+}
+
+// aggregationGroup holds the identical events aggregated over a window.
+type aggregationGroup struct {
+	typ        string
+	count      int64
+	namespaces map[string]struct{}
+}
+
+// namespaceAggregator aggregates the identical events, i.e. with the same reason and message,
+// across the namespaces over a window, to be emitted as one log per group of identical events.
+type namespaceAggregator struct {
+	maxGroups int
+	mu        sync.Mutex
+	start     time.Time
+	groups    map[aggregationKey]*aggregationGroup
+}
+
+func newNamespaceAggregator(start time.Time, maxGroups int) *namespaceAggregator {
+	return &namespaceAggregator{maxGroups: maxGroups, start: start, groups: make(map[aggregationKey]*aggregationGroup)}
+}
+
+// add aggregates the event ev with the normalized message. It returns false when ev would open
+// a group beyond the maximum number of groups, the event being left to be emitted as is.
+func (a *namespaceAggregator) add(ev *corev1.Event, message string) bool {
+	namespace := ev.InvolvedObject.Namespace
+	if namespace == "" {
+		namespace = ev.Namespace
+	}
+	key := aggregationKey{reason: ev.Reason, message: message}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	g, ok := a.groups[key]
+	if !ok {
+		if len(a.groups) >= a.maxGroups {
+			return false
+		}
+		g = &aggregationGroup{typ: ev.Type, namespaces: make(map[string]struct{})}
+		a.groups[key] = g
+	}
+	g.count++
+	if namespace != "" {
+		g.namespaces[namespace] = struct{}{}
+	}
+	return true
+}
+
+// flush builds the logs of the groups of the window ending at now, one log per group,
+// and resets the groups for the next window. ok is false when no events were aggregated.
+func (a *namespaceAggregator) flush(now time.Time) (ld plog.Logs, ok bool) {
+	a.mu.Lock()
+	groups, start := a.groups, a.start
+	a.groups, a.start = make(map[aggregationKey]*aggregationGroup), now
+	a.mu.Unlock()
+	if len(groups) == 0 {
+		return plog.Logs{}, false
+	}
+
+	keys := make([]aggregationKey, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b aggregationKey) int {
+		return cmp.Or(cmp.Compare(a.reason, b.reason), cmp.Compare(a.message, b.message))
+	})
+
+	ld = plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, k := range keys {
+		g := groups[k]
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+		if severityNumber, ok := severityMap[strings.ToLower(g.typ)]; ok {
+			lr.SetSeverityNumber(severityNumber)
+			lr.SetSeverityText(g.typ)
+		}
+		lr.Body().SetStr(k.message)
+		attrs := lr.Attributes()
+		attrs.PutStr("k8s.event.reason", k.reason)
+		attrs.PutStr("k8s.event.type", g.typ)
+		attrs.PutInt(attributeAggregationCount, g.count)
+		namespaces := make([]string, 0, len(g.namespaces))
+		for ns := range g.namespaces {
+			namespaces = append(namespaces, ns)
+		}
+		slices.Sort(namespaces)
+		s := attrs.PutEmptySlice(attributeAggregationNamespaces)
+		s.EnsureCapacity(len(namespaces))
+		for _, ns := range namespaces {
+			s.AppendEmpty().SetStr(ns)
+		}
+		attrs.PutStr(attributeAggregationWindowStart, start.UTC().Format(time.RFC3339))
+	}
+	return ld, true
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestNamespaceAggregatorFlush(t *testing.T) {
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	a := newNamespaceAggregator(start, 10)
+	// The windows without events are skipped.
+	_, ok := a.flush(start.Add(time.Minute))
+	assert.False(t, ok)
+
+	pullFailure := func(namespace string) *corev1.Event {
+		ev := getEvent()
+		ev.Namespace = namespace
+		ev.InvolvedObject.Namespace = namespace
+		ev.Reason = "Failed"
+		ev.Type = "Warning"
+		return ev
+	}
+	message := `Failed to pull image "registry.example.com/agent:1.2": unauthorized`
+	for _, ns := range []string{"payments", "checkout", "payments", "search"} {
+		require.True(t, a.add(pullFailure(ns), message))
+	}
+	require.True(t, a.add(getEvent(), "another message"))
+
+	now := start.Add(2 * time.Minute)
+	ld, ok := a.flush(now)
+	require.True(t, ok)
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	assert.Empty(t, ld.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+
+	lr := lrs.At(0)
+	assert.Equal(t, message, lr.Body().Str())
+	assert.Equal(t, "Warning", lr.SeverityText())
+	assert.Equal(t, now, lr.Timestamp().AsTime())
+	assert.Equal(t, map[string]any{
+		"k8s.event.reason":              "Failed",
+		"k8s.event.type":                "Warning",
+		attributeAggregationCount:       int64(4),
+		attributeAggregationNamespaces:  []any{"checkout", "payments", "search"},
+		attributeAggregationWindowStart: "2025-03-01T10:01:00Z",
+	}, lr.Attributes().AsRaw())
+
+	lr = lrs.At(1)
+	assert.Equal(t, "another message", lr.Body().Str())
+	assert.Equal(t, int64(1), lr.Attributes().AsRaw()[attributeAggregationCount])
+	assert.Equal(t, []any{"test"}, lr.Attributes().AsRaw()[attributeAggregationNamespaces])
+
+	// The groups are reset after a flush, the next window starting at the flush.
+	_, ok = a.flush(now.Add(time.Minute))
+	assert.False(t, ok)
+}
+
+func TestNamespaceAggregatorMaxGroups(t *testing.T) {
+	a := newNamespaceAggregator(time.Now(), 1)
+	require.True(t, a.add(getEvent(), "first message"))
+	// The events of the existing groups are still aggregated, the new groups are refused.
+	require.True(t, a.add(getEvent(), "first message"))
+	assert.False(t, a.add(getEvent(), "second message"))
+}
+
+func TestCrossNamespaceAggregationEmission(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.CrossNamespaceAggregation.Enabled = true
+	rCfg.CrossNamespaceAggregation.Window = 50 * time.Millisecond
+	rCfg.CrossNamespaceAggregation.MaxGroups = 1
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	// The events are aggregated before the start, so that they fall in the first window.
+	for _, ns := range []string{"payments", "checkout"} {
+		ev := getEvent()
+		ev.InvolvedObject.Namespace = ns
+		recv.handleEvent(ev)
+	}
+	// The events beyond the maximum number of groups are emitted as is.
+	other := getEvent()
+	other.Message = "another message"
+	recv.handleEvent(other)
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	lr := sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, int64(2), lr.Attributes().AsRaw()[attributeAggregationCount])
+	assert.Equal(t, []any{"checkout", "payments"}, lr.Attributes().AsRaw()[attributeAggregationNamespaces])
+
+	// The events aggregated since the last window are emitted on shutdown.
+	recv.handleEvent(getEvent())
+	require.NoError(t, recv.Shutdown(context.Background()))
+	require.Equal(t, 3, sink.LogRecordCount())
+	lr = sink.AllLogs()[2].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, int64(1), lr.Attributes().AsRaw()[attributeAggregationCount])
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// bodyTemplateMessage is the field of the body template expanded to the normalized message.
+const bodyTemplateMessage = "message"
+
+// bodyTemplateFields are the fields of the events the body template can be expanded with,
+// in addition to the message. The fields unset in an event expand to an empty string.
+var bodyTemplateFields = map[string]func(ev *corev1.Event) string{
+	"reason":               func(ev *corev1.Event) string { return ev.Reason },
+	"type":                 func(ev *corev1.Event) string { return ev.Type },
+	"action":               func(ev *corev1.Event) string { return ev.Action },
+	"count":                func(ev *corev1.Event) string { return strconv.Itoa(int(ev.Count)) },
+	"name":                 func(ev *corev1.Event) string { return ev.Name },
+	"namespace":            func(ev *corev1.Event) string { return ev.Namespace },
+	"object_kind":          func(ev *corev1.Event) string { return ev.InvolvedObject.Kind },
+	"object_name":          func(ev *corev1.Event) string { return ev.InvolvedObject.Name },
+	"reporting_controller": reportingController,
+}
+
+// expandBodyTemplate replaces the `{field}` placeholders of tmpl with the values returned by field.
+// Braces not forming a placeholder are kept as is. An error is returned for the unknown fields.
+func expandBodyTemplate(tmpl string, field func(name string) (string, bool)) (string, error) {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			break
+		}
+		name := tmpl[start+1 : start+end]
+		value, ok := field(name)
+		if !ok {
+			return "", fmt.Errorf("unknown field %q", name)
+		}
+		b.WriteString(tmpl[:start])
+		b.WriteString(value)
+		tmpl = tmpl[start+end+1:]
+	}
+	b.WriteString(tmpl)
+	return b.String(), nil
+}
+
+// validateBodyTemplate checks that tmpl only refers to known fields.
+func validateBodyTemplate(tmpl string) error {
+	_, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		_, ok := bodyTemplateFields[name]
+		return "", ok || name == bodyTemplateMessage
+	})
+	return err
+}
+
+// bodyTemplate returns the body template of the reason of ev, falling back to the BodyTemplate.
+func (cfg *Config) bodyTemplate(ev *corev1.Event) string {
+	if tmpl, ok := cfg.ReasonBodyTemplates[ev.Reason]; ok {
+		return tmpl
+	}
+	return cfg.BodyTemplate
+}
+
+// eventBody builds the body of the log record of ev from tmpl, with the message already normalized.
+func eventBody(tmpl string, ev *corev1.Event, message string) string {
+	if tmpl == "" {
+		return message
+	}
+	body, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		if name == bodyTemplateMessage {
+			return message, true
+		}
+		if f, ok := bodyTemplateFields[name]; ok {
+			return f(ev), true
+		}
+		return "", false
+	})
+	if err != nil {
+		// The template is validated, this only happens for configurations built in code.
+		return message
+	}
+	return body
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestEventBody(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.Reason = "BackOff"
+	k8sEvent.Message = "Back-off restarting failed container"
+	k8sEvent.Count = 3
+
+	tests := []struct {
+		tmpl     string
+		expected string
+	}{
+		{tmpl: "", expected: "Back-off restarting failed container"},
+		{tmpl: "{message}", expected: "Back-off restarting failed container"},
+		{tmpl: "{reason}: {message}", expected: "BackOff: Back-off restarting failed container"},
+		{tmpl: "{object_kind}/{object_name} {reason} (x{count}) by {reporting_controller}", expected: "Pod/test-34bcd-rn54 BackOff (x3) by testComponent"},
+		// Fields unset in the event expand to an empty string.
+		{tmpl: "[{action}] {message}", expected: "[] Back-off restarting failed container"},
+		// Braces not forming a placeholder are kept.
+		{tmpl: "} {reason} {", expected: "} BackOff {"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			assert.NoError(t, validateBodyTemplate(tt.tmpl))
+			assert.Equal(t, tt.expected, eventBody(tt.tmpl, k8sEvent, k8sEvent.Message))
+		})
+	}
+}
+
+func TestValidateBodyTemplate(t *testing.T) {
+	assert.EqualError(t, validateBodyTemplate("{reason}: {msg}"), `unknown field "msg"`)
+	assert.EqualError(t, validateBodyTemplate("{}"), `unknown field ""`)
+}
+
+func TestK8sEventToLogDataWithBodyTemplate(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.Reason = "BackOff"
+	k8sEvent.Message = "  Back-off restarting\n failed container "
+	cfg := createDefaultConfig().(*Config)
+	cfg.BodyTemplate = "{reason}: {message}"
+	cfg.NormalizeMessage = NormalizeMessageConfig{Enabled: true, CollapseWhitespace: true}
+
+	ld := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil)
+	body := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str()
+	assert.Equal(t, "BackOff: Back-off restarting failed container", body)
+}
+
+func TestK8sEventToLogDataWithReasonBodyTemplates(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BodyTemplate = "{reason}: {message}"
+	cfg.ReasonBodyTemplates = map[string]string{
+		"FailedScheduling": "{object_kind} {namespace}/{object_name} can't be scheduled: {message}",
+	}
+
+	tests := []struct {
+		name     string
+		reason   string
+		message  string
+		expected string
+	}{
+		{
+			name:     "matched",
+			reason:   "FailedScheduling",
+			message:  "0/3 nodes are available: 3 Insufficient cpu.",
+			expected: "Pod test/test-34bcd-rn54 can't be scheduled: 0/3 nodes are available: 3 Insufficient cpu.",
+		},
+		{
+			name:     "fallback",
+			reason:   "BackOff",
+			message:  "Back-off restarting failed container",
+			expected: "BackOff: Back-off restarting failed container",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.Message = tt.message
+			ld := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil)
+			body := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str()
+			assert.Equal(t, tt.expected, body)
+		})
+	}
+}
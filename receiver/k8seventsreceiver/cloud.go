@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// cloudProviderIDSchemes maps the schemes of the provider IDs of the nodes, e.g. `aws:///us-east-1a/i-0abc`,
+// set by the cloud controller managers, to the cloud providers.
+var cloudProviderIDSchemes = map[string]string{
+	"aws":   semconv.AttributeCloudProviderAWS,
+	"gce":   semconv.AttributeCloudProviderGCP,
+	"azure": semconv.AttributeCloudProviderAzure,
+}
+
+// detectCloudProvider detects the cloud provider of the cluster from the provider ID of one of its nodes.
+func detectCloudProvider(ctx context.Context, client k8s.Interface) (string, error) {
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the nodes: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		return "", errors.New("the cluster has no nodes")
+	}
+	providerID := nodes.Items[0].Spec.ProviderID
+	scheme, _, ok := strings.Cut(providerID, "://")
+	if !ok {
+		return "", fmt.Errorf("the provider ID %q of the nodes has no scheme", providerID)
+	}
+	provider, ok := cloudProviderIDSchemes[scheme]
+	if !ok {
+		return "", fmt.Errorf("unknown cloud provider %q", scheme)
+	}
+	return provider, nil
+}
@@ -4,7 +4,20 @@
 package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/extension/extensionauth"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
@@ -13,20 +26,1386 @@ import (
 type Config struct {
 	k8sconfig.APIConfig `mapstructure:",squash"`
 
+	// Auth configures an auth extension authenticating the requests to the API server,
+	// on top of the credentials of the configured auth_type.
+	Auth *configauth.Authentication `mapstructure:"auth"`
+
 	// List of ‘namespaces’ to collect events from.
 	Namespaces []string `mapstructure:"namespaces"`
 
+	// FallbackToAccessibleNamespaces watches the events of each namespace they can be watched in,
+	// when no namespaces are configured and watching the events of the whole cluster is forbidden.
+	FallbackToAccessibleNamespaces bool `mapstructure:"fallback_to_accessible_namespaces"`
+
+	// NamespaceResourceAttributes are static resource attributes added to the events of each
+	// namespace, keyed by the namespace. They take precedence over the other resource attributes.
+	NamespaceResourceAttributes map[string]map[string]string `mapstructure:"namespace_resource_attributes"`
+
+	// LookupFile configures adding the resource attributes read from a static file to the events,
+	// e.g. the cost center of the namespaces, as organizational metadata without informers.
+	LookupFile LookupFileConfig `mapstructure:"lookup_file"`
+
+	// SuppressionConfigMap configures the ConfigMap listing the reasons and the involved objects
+	// whose events are suppressed, watched to silence the floods during known incidents without
+	// redeploying the collector.
+	SuppressionConfigMap SuppressionConfigMapConfig `mapstructure:"suppression_config_map"`
+
+	// StartupJitter delays the watch of the events by a random duration up to this value,
+	// so that many collectors starting simultaneously don't list the events all at once.
+	StartupJitter time.Duration `mapstructure:"startup_jitter"`
+
+	// UpdateDebounce coalesces the updates of an event delivered within this duration, e.g.
+	// the count bumps of bursty aggregated events, emitting only the latest one. Disabled when 0.
+	UpdateDebounce time.Duration `mapstructure:"update_debounce"`
+
+	// ListPageSize is the maximum number of events fetched per request when listing the events,
+	// paginating the lists from the API server instead of serving them from its watch cache at once.
+	// The lists aren't paginated when 0.
+	ListPageSize int64 `mapstructure:"list_page_size"`
+
+	// ResyncPeriod is the period the watched events are redelivered from the cache of the informers at,
+	// for the informers to check their cache. The redelivered events, unchanged, are never emitted again.
+	// The events aren't resynced when 0.
+	ResyncPeriod time.Duration `mapstructure:"resync_period"`
+
+	// WatchAPI is the API the events are listed and watched from, either "v1" or "events.k8s.io/v1".
+	// The events of both APIs are emitted identically, except for the timestamp preferences.
+	WatchAPI string `mapstructure:"watch_api"`
+
+	// SuppressSelfEvents is the name of the controller reporting the events of the collector itself,
+	// whose events are dropped to prevent feedback loops. No events are suppressed when empty.
+	SuppressSelfEvents string `mapstructure:"suppress_self_events"`
+
+	// RequireInvolvedObject drops the events without an involved object.
+	RequireInvolvedObject bool `mapstructure:"require_involved_object"`
+
+	// ExcludeInvolvedObjectNames are glob patterns, as supported by path.Match, of the names of the
+	// involved objects whose events are dropped, e.g. "node-exporter-*" for the pods of a DaemonSet.
+	ExcludeInvolvedObjectNames []string `mapstructure:"exclude_involved_object_names"`
+
+	// DeduplicateRelists drops the events redelivered when the events are relisted, e.g. after a watch
+	// expired, by comparing their resource version with the highest one handled by the watch.
+	DeduplicateRelists bool `mapstructure:"deduplicate_relists"`
+
+	// EventTypes are the types of the events emitted, e.g. "Warning", all of them when empty.
+	// A single type is selected by the API server through a field selector, so that the other
+	// events aren't sent to the receiver at all. Several types are filtered by the receiver.
+	EventTypes []string `mapstructure:"event_types"`
+
+	// ConsistentSampleRate is the fraction of the involved objects whose events are kept, between
+	// 0 and 1. The objects are sampled by the hash of their UID, so that either all or none of the
+	// events about an object are kept. The events without an involved object UID are always kept.
+	ConsistentSampleRate float64 `mapstructure:"consistent_sample_rate"`
+
+	// CollectorNamespace configures emitting the namespace the collector
+	// itself is running in as the `k8s.collector.namespace` resource attribute.
+	CollectorNamespace CollectorNamespaceConfig `mapstructure:"collector_namespace"`
+
+	// InvolvedObjectAsMap emits the reference to the object causing the event as a single
+	// `k8s.event.object` map attribute instead of the flat `k8s.object.*` resource attributes.
+	InvolvedObjectAsMap bool `mapstructure:"involved_object_as_map"`
+
+	// SemanticObjectName additionally emits the name of the involved object under the resource
+	// attribute of the semantic conventions for its kind, e.g. `k8s.pod.name` for pods.
+	SemanticObjectName bool `mapstructure:"semantic_object_name"`
+
+	// StripGeneratedSuffix emits the name of the involved object without its generated suffixes, e.g. `web` for
+	// the pod `web-7d9f8c6b5-x2kq4`, as the `k8s.event.object.name.base` attribute, to aggregate by workload.
+	// The owners of the cached objects are followed when the enrichment is enabled, the suffixes being guessed otherwise.
+	StripGeneratedSuffix bool `mapstructure:"strip_generated_suffix"`
+
+	// EmitRate emits the `k8s.event.rate_per_minute` attribute for aggregated events.
+	EmitRate bool `mapstructure:"emit_rate"`
+
+	// EmitAge emits the time elapsed since the first occurrence of the event as the
+	// `k8s.event.age` attribute, humanized like in `kubectl get events`, e.g. `5m`.
+	EmitAge bool `mapstructure:"emit_age"`
+
+	// EmitSeenTimestamps emits the first and the last occurrences of the event, as the
+	// `k8s.event.first_seen` and `k8s.event.last_seen` attributes.
+	EmitSeenTimestamps bool `mapstructure:"emit_seen_timestamps"`
+
+	// EmitRFC3339Timestamp emits the timestamp of the log record, in RFC 3339 format in UTC, as the
+	// `k8s.event.timestamp.rfc3339` attribute, for the backends and the readers preferring a string.
+	EmitRFC3339Timestamp bool `mapstructure:"emit_rfc3339_timestamp"`
+
+	// EmitSeriesPosition emits the number of occurrences of the events reported through the `events.k8s.io`
+	// API as the `k8s.event.series.count` attribute, and whether they continue a series as the
+	// `k8s.event.series.continuation` attribute.
+	EmitSeriesPosition bool `mapstructure:"emit_series_position"`
+
+	// EmitInternalLatency emits the time elapsed between the delivery of the event by the informer
+	// and its emission by the receiver, in milliseconds, as the `k8s.event.internal_latency_ms` attribute.
+	EmitInternalLatency bool `mapstructure:"emit_internal_latency"`
+
+	// EmitMessageAttribute emits the message of the event, normalized as configured, as the
+	// `k8s.event.message` attribute, whatever the body of the log record is made of.
+	EmitMessageAttribute bool `mapstructure:"emit_message_attribute"`
+
+	// EmitContentHash emits a hash of the reason, the message and the type of the event as the
+	// `k8s.event.content_hash` attribute, to detect changes of the content of recurring events.
+	EmitContentHash bool `mapstructure:"emit_content_hash"`
+
+	// CompactSummary emits the type, the reason, the involved object and the count of the event
+	// combined in the single `k8s.event.summary` attribute, e.g. `Warning/BackOff default/my-pod x5`,
+	// for the backends displaying a single field.
+	CompactSummary bool `mapstructure:"compact_summary"`
+
+	// SourceNamespacedAttributes prefixes the event attributes with the name of the
+	// controller reporting the event, e.g. `kubelet.k8s.event.reason`.
+	SourceNamespacedAttributes bool `mapstructure:"source_namespaced_attributes"`
+
+	// EventDomainMode structures the log records as OTel events: the `event.domain` attribute is set
+	// to `k8s`, the `event.name` attribute to the reason of the event, and the `k8s.event.*`
+	// attributes are nested in the `k8s.event` map attribute.
+	EventDomainMode bool `mapstructure:"event_domain_mode"`
+
+	// EmitReportingSubsystem emits the subsystem of the node reporting the events about the pods and the nodes,
+	// i.e. `kubelet`, `containerd`, `cri-o` or `dockershim`, as the `k8s.event.reporting.subsystem` attribute.
+	EmitReportingSubsystem bool `mapstructure:"emit_reporting_subsystem"`
+
+	// ReportingControllerAsService sets the `service.name` resource attribute
+	// to the name of the controller reporting the event, e.g. `kubelet`.
+	ReportingControllerAsService bool `mapstructure:"reporting_controller_as_service"`
+
+	// NamespaceService configures setting the `service.name` resource attribute to the service owning
+	// the namespace of the event, for the APM backends to attribute the events to the owning services.
+	NamespaceService NamespaceServiceConfig `mapstructure:"namespace_service"`
+
+	// TimestampPrecision is the precision the timestamps of the log records are truncated to,
+	// one of "ns", "us", "ms" or "s".
+	TimestampPrecision string `mapstructure:"timestamp_precision"`
+
+	// CoreTimestampPreference lists the fields the timestamps of the log records of the events of
+	// the `v1` API are taken from, in order of preference, e.g. `[lastTimestamp, eventTime]`.
+	// The filters and the other time based features keep relying on the time of the event.
+	CoreTimestampPreference []string `mapstructure:"core_timestamp_preference"`
+
+	// EventsAPITimestampPreference lists the fields the timestamps of the log records of the events of
+	// the `events.k8s.io/v1` API are taken from, in order of preference, e.g. `[series.lastObservedTime, eventTime]`.
+	EventsAPITimestampPreference []string `mapstructure:"events_api_timestamp_preference"`
+
+	// NormalizeCase normalizes the case of the enum-like fields of the events, i.e. their type,
+	// reason and involved object kind, one of "none", "lower" or "upper".
+	NormalizeCase string `mapstructure:"normalize_case"`
+
+	// PartitionKeySource is the source of the `messaging.kafka.message.key` attribute partitioning the events
+	// in the sharded downstreams, one of "uid", the UID of the involved object, or "namespace". Not emitted when empty.
+	PartitionKeySource string `mapstructure:"partition_key_source"`
+
+	// DefaultReason is the `k8s.event.reason` attribute of the events without a reason, e.g. "Unknown",
+	// so that the filters on the reason don't miss them. The attribute is empty for them when not set.
+	DefaultReason string `mapstructure:"default_reason"`
+
+	// BodyTemplate builds the body of the log records from the fields of the events,
+	// e.g. "{reason}: {message}". Defaults to the message.
+	BodyTemplate string `mapstructure:"body_template"`
+
+	// ReasonBodyTemplates override the BodyTemplate for the events of the given reasons,
+	// e.g. a detailed template for the `FailedScheduling` events.
+	ReasonBodyTemplates map[string]string `mapstructure:"reason_body_templates"`
+
+	// ConsoleURLTemplate builds the URL of the involved objects in a console, e.g. the Kubernetes dashboard,
+	// emitted as the `k8s.event.console_url` attribute to jump from the logs to the objects, e.g.
+	// "https://dashboard.example.com/#/{kind_lower}/{namespace}/{name}". Not emitted when empty.
+	ConsoleURLTemplate string `mapstructure:"console_url_template"`
+
+	// StreamNameTemplate builds the name of the index or the stream the events are routed to by the
+	// exporters, emitted as the `k8s.event.stream_name` attribute, e.g. "k8s-events-{namespace}".
+	// Not emitted when empty.
+	StreamNameTemplate string `mapstructure:"stream_name_template"`
+
+	// NormalizeMessage configures the normalization of the whitespace in the event messages.
+	NormalizeMessage NormalizeMessageConfig `mapstructure:"normalize_message"`
+
+	// MessageRedactionPatterns are regular expressions matching the sensitive content of the event
+	// messages, e.g. the credentials of connection strings, replaced with "***" in the log records.
+	MessageRedactionPatterns []string `mapstructure:"message_redaction_patterns"`
+
+	// Priority configures scoring the triage priority of the events,
+	// emitted as the `k8s.event.priority` attribute.
+	Priority PriorityConfig `mapstructure:"priority"`
+
+	// IncidentSeverity configures mapping the reasons of the events to incident severity tiers,
+	// emitted as the `incident.severity` attribute.
+	IncidentSeverity IncidentSeverityConfig `mapstructure:"incident_severity"`
+
+	// Actionable configures classifying the events as actionable or informational,
+	// emitted as the `k8s.event.actionable` attribute.
+	Actionable ActionableConfig `mapstructure:"actionable"`
+
+	// FailedScheduling configures parsing the scheduling context from the messages of the
+	// FailedScheduling events into the `k8s.scheduling.*` attributes.
+	FailedScheduling FailedSchedulingConfig `mapstructure:"failed_scheduling"`
+
+	// TimestampDiscrepancy configures flagging the events whose event time and last timestamp disagree,
+	// revealing the clock skews in the cluster or a stale aggregation.
+	TimestampDiscrepancy TimestampDiscrepancyConfig `mapstructure:"timestamp_discrepancy"`
+
+	// ResourceGroupBy lists the attributes forming the resource of the emitted logs,
+	// all the other attributes are set on the log records.
+	ResourceGroupBy []string `mapstructure:"resource_group_by"`
+
+	// SeverityText configures deriving backend friendly severity texts from the events.
+	SeverityText SeverityTextConfig `mapstructure:"severity_text"`
+
+	// AttributeLimits configures limiting the number of attributes of the log records.
+	AttributeLimits AttributeLimitsConfig `mapstructure:"attribute_limits"`
+
+	// SortAttributes sorts the resource and the log attributes, including the nested maps, in the lexical
+	// order of their keys, for the downstream systems diffing the records to get the same order across runs.
+	SortAttributes bool `mapstructure:"sort_attributes"`
+
+	// EmitCollectorVersion emits the version of the collector build
+	// as the `k8s.collector.version` resource attribute.
+	EmitCollectorVersion bool `mapstructure:"emit_collector_version"`
+
+	// EmitInstanceID emits an ID generated when the receiver is created, identifying the
+	// collector process emitting the events, as the `k8s.collector.instance.id` resource attribute.
+	EmitInstanceID bool `mapstructure:"emit_instance_id"`
+
+	// EmitAPIServerEndpoint emits the host of the API server the events are watched from
+	// as the `k8s.apiserver.endpoint` resource attribute, with any credentials redacted.
+	EmitAPIServerEndpoint bool `mapstructure:"emit_api_server_endpoint"`
+
+	// EmitConfigHash emits a hash of the configuration of the receiver as the `k8s.events.config_hash`
+	// resource attribute, to correlate the shifts in the volume of the events with the configuration changes.
+	EmitConfigHash bool `mapstructure:"emit_config_hash"`
+
+	// EmitWatchScope emits whether the events are watched in the whole cluster or in a set of namespaces,
+	// as the `k8s.event.watch.scope` attribute set to `cluster` or `namespaced`.
+	EmitWatchScope bool `mapstructure:"emit_watch_scope"`
+
+	// CloudProvider configures emitting the cloud provider of the cluster as the `cloud.provider`
+	// resource attribute, for the aggregation of the events across clouds.
+	CloudProvider CloudProviderConfig `mapstructure:"cloud_provider"`
+
+	// Enrichment configures caching the objects involved in the events,
+	// which the features looking into the involved objects rely on.
+	Enrichment EnrichmentConfig `mapstructure:"enrichment"`
+
+	// MinInvolvedObjectAge drops the events occurring earlier than this duration after the
+	// creation of their involved object. Requires the enrichment of the object's kind.
+	MinInvolvedObjectAge time.Duration `mapstructure:"min_involved_object_age"`
+
+	// InvolvedObjectAnnotationSelector configures emitting only the events about
+	// the objects carrying the given annotations. Requires the enrichment.
+	InvolvedObjectAnnotationSelector AnnotationSelectorConfig `mapstructure:"involved_object_annotation_selector"`
+
+	// EmitObjectAgeAtEvent emits the time elapsed between the creation of the cached involved object and
+	// the event, in seconds, as the `k8s.event.object_age_at_event_seconds` attribute. Requires the enrichment.
+	EmitObjectAgeAtEvent bool `mapstructure:"emit_object_age_at_event"`
+
+	// EmitRawObject emits the cached involved object of the event, as JSON without its managed fields,
+	// as the `k8s.object.raw` attribute for deep debugging. Verbose, since the objects commonly take
+	// a few kilobytes. Requires the enrichment.
+	EmitRawObject bool `mapstructure:"emit_raw_object"`
+
+	// EmitContainerTermination emits the reason and the exit code of the last termination
+	// of the container involved in the crash events of pods, as the
+	// `k8s.container.last_termination.*` attributes. Requires the enrichment of the Pod kind.
+	EmitContainerTermination bool `mapstructure:"emit_container_termination"`
+
+	// EmitProbeStatus emits the type of the failing probe of the probe events of pods, parsed from
+	// their message, and whether the pod is currently ready, as the `k8s.probe.type` and `k8s.pod.ready`
+	// attributes. Requires the enrichment of the Pod kind.
+	EmitProbeStatus bool `mapstructure:"emit_probe_status"`
+
+	// EmitWorkloadGeneration emits the generation of the Deployments and the StatefulSets involved
+	// in the events, and the generation observed by their controller, as the
+	// `k8s.workload.generation` and `k8s.workload.observed_generation` attributes.
+	// Requires the enrichment of these kinds.
+	EmitWorkloadGeneration bool `mapstructure:"emit_workload_generation"`
+
+	// EmitObjectHealth emits the health of the Deployments, the Nodes and the pods involved in the events,
+	// derived from their conditions, as the `k8s.object.health` resource attribute set to `healthy`,
+	// `degraded` or `unknown`. Requires the enrichment of these kinds.
+	EmitObjectHealth bool `mapstructure:"emit_object_health"`
+
+	// EmitControllerRevision emits the revision of the rollout the events about the Deployments, the StatefulSets,
+	// the ReplicaSets and the pods belong to, i.e. the hash of the pod template or the controller revision, as the
+	// `k8s.controller.revision` attribute. Requires the enrichment.
+	EmitControllerRevision bool `mapstructure:"emit_controller_revision"`
+
+	// EmitJobStatus emits the number of the succeeded and the failed pods of the Jobs involved in the events,
+	// as the `k8s.job.succeeded` and `k8s.job.failed` attributes, and the CronJob owning them, if any, as the
+	// `k8s.cronjob.name` resource attribute. Requires the enrichment of the Job kind.
+	EmitJobStatus bool `mapstructure:"emit_job_status"`
+
+	// ResolveNodeName sets the `k8s.node.name` resource attribute to the node the involved object
+	// maps to: the node itself for Node events, the node a pod is scheduled on for Pod events.
+	// Requires the enrichment of the Pod kind.
+	ResolveNodeName bool `mapstructure:"resolve_node_name"`
+
+	// EmitReportingZone emits the zone of the node reporting the kubelet events, as opposed to the node of
+	// the involved object, as the `cloud.availability_zone` resource attribute. Requires the enrichment of
+	// the Node kind.
+	EmitReportingZone bool `mapstructure:"emit_reporting_zone"`
+
+	// EmitServiceNetwork emits the connection info of the Services and the Endpoints involved
+	// in the events, i.e. their addresses and ports, as the `network.peer.address` and
+	// `k8s.service.*` or `k8s.endpoints.*` attributes. Requires the enrichment of these kinds.
+	EmitServiceNetwork bool `mapstructure:"emit_service_network"`
+
+	// EmitStorageBinding emits the binding info of the PersistentVolumeClaims and the PersistentVolumes
+	// involved in the events, i.e. their storage class, phase and bound volume or claim, as the
+	// `k8s.pvc.*` and `k8s.pv.*` attributes. Requires the enrichment of these kinds.
+	EmitStorageBinding bool `mapstructure:"emit_storage_binding"`
+
+	// EmitResourceQuota emits the usage and the limits of the ResourceQuotas involved in the events, or
+	// exceeded according to their message, as the `k8s.resource_quota.*` attributes.
+	// Requires the enrichment of the ResourceQuota kind.
+	EmitResourceQuota bool `mapstructure:"emit_resource_quota"`
+
+	// WorkloadSelector configures emitting only the events about the selected workloads
+	// and the objects they own. Requires the enrichment of the ReplicaSet and Pod kinds.
+	WorkloadSelector WorkloadSelectorConfig `mapstructure:"workload_selector"`
+
+	// NamespaceOwnerAnnotation is the annotation of the event's namespace holding the owner of
+	// the namespace, emitted as the `k8s.namespace.owner` resource attribute.
+	// Requires the enrichment of the Namespace kind.
+	NamespaceOwnerAnnotation string `mapstructure:"namespace_owner_annotation"`
+
+	// InvolvedObjectLabelKeys are the keys of the labels of the involved objects emitted as the
+	// `k8s.<kind>.label.<key>` resource attributes, e.g. `k8s.pod.label.app`, rather than all the labels
+	// to control the cardinality. Requires the enrichment of the kinds of the involved objects.
+	InvolvedObjectLabelKeys []string `mapstructure:"involved_object_label_keys"`
+
+	// ParseJSONAnnotations are the keys of the annotations holding JSON values, parsed into the
+	// structured `k8s.event.annotation.<key>` attributes of the events and, with the enrichment of
+	// the kinds of the involved objects, the `k8s.<kind>.annotation.<key>` resource attributes.
+	// The values that aren't valid JSON are emitted as strings.
+	ParseJSONAnnotations []string `mapstructure:"parse_json_annotations"`
+
+	// EmitMatchedFilters lists the filters each event passed in the `k8s.event.matched_filters`
+	// attribute, to help debugging why events are kept. Adds overhead, meant for debugging only.
+	EmitMatchedFilters bool `mapstructure:"emit_matched_filters"`
+
+	// EmitShutdownSummary emits a summary log with the numbers of the events processed and
+	// dropped, by reason, and the uptime of the receiver when the receiver is shut down.
+	EmitShutdownSummary bool `mapstructure:"emit_shutdown_summary"`
+
+	// Maintenance configures the planned maintenance windows during
+	// which events are either dropped or flagged.
+	Maintenance MaintenanceConfig `mapstructure:"maintenance"`
+
+	// ActiveSchedule configures the times of the week during which the events are emitted,
+	// the events occurring outside of the schedule being dropped.
+	ActiveSchedule ActiveScheduleConfig `mapstructure:"active_schedule"`
+
+	// MinCountGrowthRate configures emitting only the events whose count grows faster than a rate,
+	// to surface the escalating problems while ignoring the slow or stable ones.
+	MinCountGrowthRate CountGrowthRateConfig `mapstructure:"min_count_growth_rate"`
+
+	// FirstOccurrenceOnly configures emitting only the first event of each reason for each
+	// involved object, to alert on new problems without the noise of their recurrences.
+	FirstOccurrenceOnly FirstOccurrenceConfig `mapstructure:"first_occurrence_only"`
+
+	// DeadLetterLog configures recording the events which failed to be consumed,
+	// and are thus lost, in the logs of the collector.
+	DeadLetterLog DeadLetterLogConfig `mapstructure:"dead_letter_log"`
+
+	// LoadSheddingOnFull configures dropping the events for a cooldown period once the pipeline
+	// reports being at capacity, rather than pushing more events into it.
+	LoadSheddingOnFull LoadSheddingConfig `mapstructure:"load_shedding_on_full"`
+
+	// AdaptiveThrottle configures limiting the rate the events are emitted at, reduced when the
+	// latency of the pipeline climbs, to protect the collector during the downstream slowdowns.
+	AdaptiveThrottle AdaptiveThrottleConfig `mapstructure:"adaptive_throttle"`
+
+	// IncidentGrouping configures grouping the events about the same object
+	// occurring close to each other into incidents identified by `k8s.incident.id`.
+	IncidentGrouping IncidentGroupingConfig `mapstructure:"incident_grouping"`
+
+	// ReasonStreak configures emitting the number of consecutive events of the same reason about
+	// each object as `k8s.event.reason_streak`, to tell the persistent conditions from the intermittent ones.
+	ReasonStreak ReasonStreakConfig `mapstructure:"reason_streak"`
+
+	// ResolvedEvents configures emitting synthetic logs flagged with `k8s.event.resolved` when the
+	// Warning events clear, for the downstream alerts to resolve automatically.
+	ResolvedEvents ResolvedEventsConfig `mapstructure:"resolved_events"`
+
+	// Digest configures emitting periodic digests counting the events by namespace,
+	// reason and type, instead of one log per event.
+	Digest DigestConfig `mapstructure:"digest"`
+
+	// DropDigest configures emitting periodic digests counting the events dropped by the filters,
+	// to surface their impact without logging every dropped event.
+	DropDigest DropDigestConfig `mapstructure:"drop_digest"`
+
+	// CrossNamespaceAggregation configures aggregating the identical events across the namespaces
+	// over a window, instead of one log per event, to surface the issues of the whole cluster.
+	CrossNamespaceAggregation CrossNamespaceAggregationConfig `mapstructure:"cross_namespace_aggregation"`
+
+	// Routing configures tagging the events with the route, derived from their type, of the pipeline
+	// they are meant for, for a downstream routing connector to split the events between pipelines.
+	Routing RoutingConfig `mapstructure:"routing"`
+
 	// For mocking
 	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 }
 
+// AttributeLimitsConfig defines how the attributes of the log records are trimmed.
+type AttributeLimitsConfig struct {
+	// MaxAttributes is the maximum number of attributes of a log record, including the
+	// `k8s.event.attributes.trimmed` flag set on the trimmed records. No limit when 0.
+	MaxAttributes int `mapstructure:"max_attributes"`
+
+	// Priority lists the attributes kept first when trimming, in order.
+	// The other attributes are kept in the lexical order of their keys.
+	Priority []string `mapstructure:"priority"`
+}
+
+// CollectorNamespaceConfig defines how the collector's own namespace is discovered.
+type CollectorNamespaceConfig struct {
+	// Enabled adds the `k8s.collector.namespace` resource attribute to every event.
+	Enabled bool `mapstructure:"enabled"`
+
+	// EnvVar is the name of the environment variable holding the collector's namespace,
+	// usually populated using the downward API. The attribute is omitted when the variable is unset.
+	EnvVar string `mapstructure:"env_var"`
+}
+
+// NamespaceServiceConfig maps the namespaces to the services owning them.
+type NamespaceServiceConfig struct {
+	// Services maps the namespaces to the names of the services owning them.
+	Services map[string]string `mapstructure:"services"`
+
+	// Default is the service of the events of the namespaces not mapped. These events keep
+	// the service of reporting_controller_as_service, if any, when empty.
+	Default string `mapstructure:"default"`
+}
+
+// service returns the service owning the namespace ns.
+func (cfg *NamespaceServiceConfig) service(ns string) string {
+	if service, ok := cfg.Services[ns]; ok {
+		return service
+	}
+	return cfg.Default
+}
+
+// CloudProviderConfig defines how the cloud provider of the cluster is resolved.
+type CloudProviderConfig struct {
+	// Name is the cloud provider of the cluster, e.g. "aws", "gcp" or "azure". Takes precedence over the detection.
+	Name string `mapstructure:"name"`
+
+	// Detect detects the cloud provider from the provider ID of a node of the cluster when the receiver
+	// starts, when no name is configured. The attribute is omitted when the detection fails.
+	Detect bool `mapstructure:"detect"`
+}
+
+// EnrichmentConfig defines which involved objects are cached.
+type EnrichmentConfig struct {
+	// Enabled starts informers caching the involved objects.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Kinds of the involved objects to cache.
+	Kinds []string `mapstructure:"kinds"`
+
+	// Timeout is how long the lookups of the involved objects wait for
+	// the cache of their kind to be synced, e.g. right after the start.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Fallback is applied to the events whose involved object can't be looked up.
+	// Either "emit_without" to emit the events without the enrichment or "drop" to drop them.
+	Fallback string `mapstructure:"fallback"`
+
+	// OwnerChainCache caches the owners chains of the involved objects, e.g. the ReplicaSet
+	// and the Deployment of a pod, instead of walking them for every event.
+	OwnerChainCache OwnerChainCacheConfig `mapstructure:"owner_chain_cache"`
+}
+
+// OwnerChainCacheConfig defines the cache of the owners chains of the involved objects.
+type OwnerChainCacheConfig struct {
+	// Enabled caches the owners chains.
+	Enabled bool `mapstructure:"enabled"`
+
+	// TTL is how long the owners chains are cached for. The chains are also forgotten
+	// once their object is deleted or its controller changes.
+	TTL time.Duration `mapstructure:"ttl"`
+
+	// MaxEntries bounds the number of cached owners chains, the least recently used ones
+	// being evicted first.
+	MaxEntries int `mapstructure:"max_entries"`
+
+	// MaxConcurrency bounds the number of owners chains walked at a time on cache misses.
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+}
+
+// AnnotationSelectorConfig defines the annotations the involved objects must carry.
+type AnnotationSelectorConfig struct {
+	// MatchAnnotations are the annotations, with their values, that the involved object
+	// must all carry for its events to be emitted. No events are filtered when empty.
+	MatchAnnotations map[string]string `mapstructure:"match_annotations"`
+
+	// NotCached is applied to the events about objects missing from the cache.
+	// Either "allow" to emit the events or "drop" to drop them.
+	NotCached string `mapstructure:"not_cached"`
+}
+
+// FailedSchedulingConfig defines how the FailedScheduling events are parsed.
+type FailedSchedulingConfig struct {
+	// Enabled parses the messages of the FailedScheduling events.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxReasons is the maximum number of node rejection reasons emitted,
+	// the reasons rejecting the most nodes first. All the reasons are emitted when 0.
+	MaxReasons int `mapstructure:"max_reasons"`
+}
+
+// TimestampDiscrepancyConfig defines how the disagreeing timestamps of the events are flagged.
+type TimestampDiscrepancyConfig struct {
+	// Enabled emits the difference between the event time and the last timestamp of the events,
+	// in seconds, as the `k8s.event.timestamp.discrepancy_seconds` attribute when above Threshold.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Threshold is the difference between the timestamps above which the events are flagged.
+	Threshold time.Duration `mapstructure:"threshold"`
+
+	// LogWarning logs a warning for each flagged event as well.
+	LogWarning bool `mapstructure:"log_warning"`
+}
+
+// WorkloadSelectorConfig defines the workloads whose events are emitted.
+type WorkloadSelectorConfig struct {
+	// Deployments are the selected deployments, as "namespace/name". The events about
+	// the deployments, their replica sets and their pods are emitted with the
+	// `k8s.deployment.name` resource attribute. No events are filtered when empty.
+	Deployments []string `mapstructure:"deployments"`
+}
+
+// NormalizeMessageConfig defines how the event messages are normalized.
+type NormalizeMessageConfig struct {
+	// Enabled trims the leading and trailing whitespace of the messages.
+	Enabled bool `mapstructure:"enabled"`
+
+	// CollapseWhitespace additionally replaces the internal runs
+	// of whitespace, including newlines, with a single space.
+	CollapseWhitespace bool `mapstructure:"collapse_whitespace"`
+}
+
+// SeverityTextConfig defines the mapping of the events to severity texts.
+type SeverityTextConfig struct {
+	// Enabled sets the severity text, and the matching severity number,
+	// of the log records from the mappings below.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Types maps the event types to severity texts.
+	Types map[string]string `mapstructure:"types"`
+
+	// Reasons maps the event reasons to severity texts. Reasons take precedence over types.
+	Reasons map[string]string `mapstructure:"reasons"`
+
+	// Keywords maps the keywords found in the reasons or the messages of the events, case insensitively,
+	// to severity texts, for the reasons with no mapping. The highest severity among the found keywords
+	// is used. Keywords take precedence over types.
+	Keywords map[string]string `mapstructure:"keywords"`
+}
+
+// PriorityConfig defines how the triage priority of the events is scored.
+type PriorityConfig struct {
+	// Enabled emits the priority of the events.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Weights are the weights of the factors of the priority.
+	Weights PriorityWeightsConfig `mapstructure:"weights"`
+
+	// CriticalReasons are the reasons raising the priority of the events.
+	CriticalReasons []string `mapstructure:"critical_reasons"`
+
+	// SaturationCount is the number of occurrences from which the count factor is maximal.
+	SaturationCount int32 `mapstructure:"saturation_count"`
+
+	// RecencyWindow is the time since the last occurrence after which the recency factor is null.
+	RecencyWindow time.Duration `mapstructure:"recency_window"`
+}
+
+// PriorityWeightsConfig defines the weights of the factors of the priority.
+type PriorityWeightsConfig struct {
+	// Type is the weight of the Warning type.
+	Type float64 `mapstructure:"type"`
+
+	// Reason is the weight of the critical reasons.
+	Reason float64 `mapstructure:"reason"`
+
+	// Count is the weight of the number of occurrences.
+	Count float64 `mapstructure:"count"`
+
+	// Recency is the weight of the time since the last occurrence.
+	Recency float64 `mapstructure:"recency"`
+}
+
+// IncidentSeverityConfig defines the mapping of the reasons of the events to incident severity tiers.
+type IncidentSeverityConfig struct {
+	// Enabled emits the incident severity tiers of the events with a mapped reason.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Reasons maps the reasons to incident severity tiers, from SEV1 to SEV4, overriding the
+	// default mapping of the well-known Warning reasons. An empty tier unmaps a default reason.
+	Reasons map[string]string `mapstructure:"reasons"`
+}
+
+// ActionableConfig defines the events classified as actionable.
+type ActionableConfig struct {
+	// Enabled emits whether the events are actionable.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Types are the types of the actionable events, compared case insensitively.
+	Types []string `mapstructure:"types"`
+
+	// Reasons are the reasons of the actionable events, whatever their type.
+	Reasons []string `mapstructure:"reasons"`
+
+	// InformationalReasons are the reasons of the events never actionable, whatever their type.
+	// They take precedence over the types and the reasons above.
+	InformationalReasons []string `mapstructure:"informational_reasons"`
+}
+
+// MaintenanceConfig defines the planned maintenance windows.
+type MaintenanceConfig struct {
+	// Windows are the time ranges of the planned maintenance.
+	Windows []TimeRange `mapstructure:"windows"`
+
+	// Action is applied to the events occurring during a maintenance window.
+	// Either "drop" to drop the events or "flag" to emit them with
+	// the `k8s.event.maintenance` attribute set to true.
+	Action string `mapstructure:"action"`
+}
+
+// FirstOccurrenceConfig defines how the first occurrences of the reasons are remembered.
+type FirstOccurrenceConfig struct {
+	// Enabled suppresses the events of a reason already seen for their involved object.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxEntries is the maximum number of reasons and objects remembered,
+	// the oldest ones being forgotten first.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// CountGrowthRateConfig defines the filtering of the events by the growth of their count.
+type CountGrowthRateConfig struct {
+	// Enabled drops the events whose count grows slower than PerMinute.
+	Enabled bool `mapstructure:"enabled"`
+
+	// PerMinute is the minimum growth of the count of the events emitted, in events per minute.
+	PerMinute float64 `mapstructure:"per_minute"`
+
+	// MaxEntries is the maximum number of events whose count is remembered,
+	// the least recently seen ones being forgotten first.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// DeadLetterLogConfig defines how the lost events are logged.
+type DeadLetterLogConfig struct {
+	// Enabled logs the key fields of the events which failed to be consumed,
+	// along with the error, at the error level.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxPerMinute is the maximum number of lost events logged per minute.
+	MaxPerMinute int `mapstructure:"max_per_minute"`
+}
+
+// LoadSheddingConfig defines how the events are shed while the pipeline is at capacity.
+type LoadSheddingConfig struct {
+	// Enabled drops the events during the cooldown following an error reporting the pipeline being at capacity.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Cooldown is the period during which the events are dropped after the last capacity error.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+}
+
+// AdaptiveThrottleConfig defines how the rate of the events adapts to the latency of the pipeline.
+type AdaptiveThrottleConfig struct {
+	// Enabled limits the rate of the events, adapting it to the latency of the pipeline.
+	Enabled bool `mapstructure:"enabled"`
+
+	// TargetLatency is the latency of the consumption of the events above which the rate is halved.
+	TargetLatency time.Duration `mapstructure:"target_latency"`
+
+	// MinRate is the minimum rate of the events, in events per second.
+	MinRate float64 `mapstructure:"min_rate"`
+
+	// MaxRate is the maximum rate of the events, in events per second, which is also the initial rate.
+	MaxRate float64 `mapstructure:"max_rate"`
+}
+
+// DigestConfig defines the digests of the events.
+type DigestConfig struct {
+	// Enabled emits the digests instead of the events.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Interval is the interval the events are counted over, a digest being emitted at the end of each.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// DropDigestConfig defines the digests of the dropped events.
+type DropDigestConfig struct {
+	// Enabled emits the digests of the dropped events along with the events.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Interval is the interval the dropped events are counted over, a digest being emitted at the end of each.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// CrossNamespaceAggregationConfig defines the aggregation of the identical events across the namespaces.
+type CrossNamespaceAggregationConfig struct {
+	// Enabled emits the aggregations of the identical events instead of the events.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Window is the window the events are aggregated over, the aggregations being emitted at the end of each.
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxGroups is the maximum number of groups of identical events aggregated over a window.
+	// The events beyond are emitted as is.
+	MaxGroups int `mapstructure:"max_groups"`
+}
+
+// LookupFileConfig defines the static file the events are enriched from.
+type LookupFileConfig struct {
+	// Path is the path of the YAML or JSON file mapping the namespaces and the names of the involved
+	// objects to resource attributes. No attributes are added when empty.
+	Path string `mapstructure:"path"`
+
+	// ReloadInterval is the interval the file is checked for changes at, reloading it once modified.
+	// The file is only loaded at startup when 0.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+// SuppressionConfigMapConfig defines the ConfigMap the suppressed events are read from.
+type SuppressionConfigMapConfig struct {
+	// Namespace is the namespace of the ConfigMap.
+	Namespace string `mapstructure:"namespace"`
+
+	// Name is the name of the ConfigMap. Nothing is suppressed when empty.
+	Name string `mapstructure:"name"`
+}
+
+// IncidentGroupingConfig defines how the events are grouped into incidents.
+type IncidentGroupingConfig struct {
+	// Enabled emits the ID of the incident of each event as the `k8s.incident.id` attribute.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Window is the quiet period after the last event of an object closing its incident.
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxObjects is the maximum number of objects whose incidents are tracked.
+	MaxObjects int `mapstructure:"max_objects"`
+}
+
+// ReasonStreakConfig defines how the streaks of reasons of the objects are tracked.
+type ReasonStreakConfig struct {
+	// Enabled emits the streak of the reason of each event about an object as the `k8s.event.reason_streak` attribute.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxObjects is the maximum number of objects whose streaks are tracked.
+	MaxObjects int `mapstructure:"max_objects"`
+}
+
+// ResolvedEventsConfig defines when the Warning events are resolved.
+type ResolvedEventsConfig struct {
+	// Enabled emits a resolved log for each Warning event of an object and reason, once a Normal event
+	// about the same object occurs or the Warning event doesn't recur during the quiet period.
+	Enabled bool `mapstructure:"enabled"`
+
+	// QuietPeriod is the time after the last occurrence of a Warning event resolving it.
+	QuietPeriod time.Duration `mapstructure:"quiet_period"`
+
+	// MaxEntries is the maximum number of Warning events tracked, by object and reason.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// RoutingConfig defines the routes of the events by type.
+type RoutingConfig struct {
+	// Attribute is the key of the resource attribute holding the route. The events aren't tagged when empty.
+	Attribute string `mapstructure:"attribute"`
+
+	// Routes maps the event types, matched case insensitively, to routes.
+	Routes map[string]string `mapstructure:"routes"`
+
+	// Default is the route of the events whose type has no route. They aren't tagged when empty.
+	Default string `mapstructure:"default"`
+}
+
+// ActiveScheduleConfig defines the times of the week during which the events are emitted.
+type ActiveScheduleConfig struct {
+	// Timezone is the IANA name of the time zone of the ranges, e.g. "Europe/Paris". Defaults to UTC.
+	Timezone string `mapstructure:"timezone"`
+
+	// Ranges are the daily time ranges during which the events are emitted.
+	// The events are emitted at all times when empty.
+	Ranges []ScheduleRange `mapstructure:"ranges"`
+}
+
+// ScheduleRange is a daily time range on some days of the week.
+type ScheduleRange struct {
+	// Days are the days of the week the range starts on, e.g. "Monday".
+	Days []string `mapstructure:"days"`
+
+	// Start is the time of the day the range starts at, inclusive, as "15:04".
+	Start string `mapstructure:"start"`
+
+	// End is the time of the day the range ends at, exclusive, as "15:04".
+	// A range ending earlier than it starts ends on the next day.
+	End string `mapstructure:"end"`
+}
+
+// TimeRange is a time range including its start and excluding its end.
+type TimeRange struct {
+	Start time.Time `mapstructure:"start"`
+	End   time.Time `mapstructure:"end"`
+}
+
+const (
+	maintenanceActionDrop = "drop"
+	maintenanceActionFlag = "flag"
+)
+
+// timestampPrecisions maps the supported timestamp precisions to their durations.
+var timestampPrecisions = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+}
+
+const (
+	normalizeCaseNone  = "none"
+	normalizeCaseLower = "lower"
+	normalizeCaseUpper = "upper"
+)
+
+const (
+	partitionKeySourceUID       = "uid"
+	partitionKeySourceNamespace = "namespace"
+)
+
+const (
+	enrichmentFallbackEmitWithout = "emit_without"
+	enrichmentFallbackDrop        = "drop"
+)
+
+const (
+	watchAPICore   = "v1"
+	watchAPIEvents = "events.k8s.io/v1"
+)
+
+const (
+	notCachedActionAllow = "allow"
+	notCachedActionDrop  = "drop"
+)
+
 func (cfg *Config) Validate() error {
+	if cfg.CollectorNamespace.Enabled && cfg.CollectorNamespace.EnvVar == "" {
+		return errors.New("collector_namespace.env_var must be set when collector_namespace is enabled")
+	}
+	for ns := range cfg.NamespaceResourceAttributes {
+		if len(cfg.Namespaces) > 0 && !slices.Contains(cfg.Namespaces, ns) {
+			return fmt.Errorf("namespace_resource_attributes: namespace %q is not watched", ns)
+		}
+	}
+	if cfg.StartupJitter < 0 {
+		return errors.New("startup_jitter must not be negative")
+	}
+	if cfg.UpdateDebounce < 0 {
+		return errors.New("update_debounce must not be negative")
+	}
+	if err := cfg.LookupFile.Validate(); err != nil {
+		return fmt.Errorf("lookup_file: %w", err)
+	}
+	if err := cfg.SuppressionConfigMap.Validate(); err != nil {
+		return fmt.Errorf("suppression_config_map: %w", err)
+	}
+	if cfg.ResyncPeriod < 0 {
+		return errors.New("resync_period must not be negative")
+	}
+	if cfg.ListPageSize < 0 {
+		return errors.New("list_page_size must not be negative")
+	}
+	if cfg.WatchAPI != watchAPICore && cfg.WatchAPI != watchAPIEvents {
+		return fmt.Errorf("invalid watch_api %q, must be one of %q or %q", cfg.WatchAPI, watchAPICore, watchAPIEvents)
+	}
+	for _, typ := range cfg.EventTypes {
+		if typ == "" {
+			return errors.New("event_types must not contain empty types")
+		}
+	}
+	for _, pattern := range cfg.ExcludeInvolvedObjectNames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid exclude_involved_object_names pattern %q: %w", pattern, err)
+		}
+	}
+	if cfg.ConsistentSampleRate <= 0 || cfg.ConsistentSampleRate > 1 {
+		return errors.New("consistent_sample_rate must be greater than 0 and at most 1")
+	}
+	if _, ok := timestampPrecisions[cfg.TimestampPrecision]; !ok {
+		return fmt.Errorf(`invalid timestamp_precision %q, must be one of "ns", "us", "ms" or "s"`, cfg.TimestampPrecision)
+	}
+	if err := validateTimestampPreference(cfg.CoreTimestampPreference, coreTimestampFields); err != nil {
+		return fmt.Errorf("core_timestamp_preference: %w", err)
+	}
+	if err := validateTimestampPreference(cfg.EventsAPITimestampPreference, eventsAPITimestampFields); err != nil {
+		return fmt.Errorf("events_api_timestamp_preference: %w", err)
+	}
+	switch cfg.NormalizeCase {
+	case normalizeCaseNone, normalizeCaseLower, normalizeCaseUpper:
+	default:
+		return fmt.Errorf("invalid normalize_case %q, must be one of %q, %q or %q",
+			cfg.NormalizeCase, normalizeCaseNone, normalizeCaseLower, normalizeCaseUpper)
+	}
+	switch cfg.PartitionKeySource {
+	case "", partitionKeySourceUID, partitionKeySourceNamespace:
+	default:
+		return fmt.Errorf("invalid partition_key_source %q, must be one of %q or %q",
+			cfg.PartitionKeySource, partitionKeySourceUID, partitionKeySourceNamespace)
+	}
+	if err := validateBodyTemplate(cfg.BodyTemplate); err != nil {
+		return fmt.Errorf("body_template: %w", err)
+	}
+	for reason, tmpl := range cfg.ReasonBodyTemplates {
+		if err := validateBodyTemplate(tmpl); err != nil {
+			return fmt.Errorf("reason_body_templates: reason %q: %w", reason, err)
+		}
+	}
+	for ns, service := range cfg.NamespaceService.Services {
+		if service == "" {
+			return fmt.Errorf("namespace_service: service of namespace %q must not be empty", ns)
+		}
+	}
+	if err := validateConsoleURLTemplate(cfg.ConsoleURLTemplate); err != nil {
+		return fmt.Errorf("console_url_template: %w", err)
+	}
+	if err := validateStreamNameTemplate(cfg.StreamNameTemplate); err != nil {
+		return fmt.Errorf("stream_name_template: %w", err)
+	}
+	if _, err := compileRedactions(cfg.MessageRedactionPatterns); err != nil {
+		return err
+	}
+	if cfg.FailedScheduling.MaxReasons < 0 {
+		return errors.New("failed_scheduling.max_reasons must not be negative")
+	}
+	if cfg.TimestampDiscrepancy.Enabled && cfg.TimestampDiscrepancy.Threshold <= 0 {
+		return errors.New("timestamp_discrepancy.threshold must be positive")
+	}
+	if cfg.AttributeLimits.MaxAttributes < 0 {
+		return errors.New("attribute_limits.max_attributes must not be negative")
+	}
+	if err := cfg.Enrichment.Validate(); err != nil {
+		return fmt.Errorf("enrichment: %w", err)
+	}
+	if cfg.MinInvolvedObjectAge < 0 {
+		return errors.New("min_involved_object_age must not be negative")
+	}
+	if cfg.MinInvolvedObjectAge > 0 && !cfg.Enrichment.Enabled {
+		return errors.New("min_involved_object_age requires enrichment to be enabled")
+	}
+	if err := cfg.InvolvedObjectAnnotationSelector.Validate(); err != nil {
+		return fmt.Errorf("involved_object_annotation_selector: %w", err)
+	}
+	if len(cfg.InvolvedObjectAnnotationSelector.MatchAnnotations) > 0 && !cfg.Enrichment.Enabled {
+		return errors.New("involved_object_annotation_selector requires enrichment to be enabled")
+	}
+	if cfg.EmitContainerTermination && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Pod")) {
+		return errors.New("emit_container_termination requires enrichment of the Pod kind")
+	}
+	if cfg.EmitProbeStatus && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Pod")) {
+		return errors.New("emit_probe_status requires enrichment of the Pod kind")
+	}
+	if cfg.EmitWorkloadGeneration && (!cfg.Enrichment.Enabled ||
+		!slices.Contains(cfg.Enrichment.Kinds, "Deployment") && !slices.Contains(cfg.Enrichment.Kinds, "StatefulSet")) {
+		return errors.New("emit_workload_generation requires enrichment of the Deployment or StatefulSet kind")
+	}
+	if cfg.EmitObjectHealth && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Deployment") &&
+		!slices.Contains(cfg.Enrichment.Kinds, "Node") && !slices.Contains(cfg.Enrichment.Kinds, "Pod")) {
+		return errors.New("emit_object_health requires enrichment of the Deployment, Node or Pod kind")
+	}
+	if cfg.EmitControllerRevision && !cfg.Enrichment.Enabled {
+		return errors.New("emit_controller_revision requires enrichment")
+	}
+	if cfg.EmitJobStatus && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Job")) {
+		return errors.New("emit_job_status requires enrichment of the Job kind")
+	}
+	if cfg.ResolveNodeName && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Pod")) {
+		return errors.New("resolve_node_name requires enrichment of the Pod kind")
+	}
+	if cfg.EmitReportingZone && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Node")) {
+		return errors.New("emit_reporting_zone requires enrichment of the Node kind")
+	}
+	if cfg.EmitServiceNetwork && (!cfg.Enrichment.Enabled ||
+		!slices.Contains(cfg.Enrichment.Kinds, "Service") && !slices.Contains(cfg.Enrichment.Kinds, "Endpoints")) {
+		return errors.New("emit_service_network requires enrichment of the Service or Endpoints kind")
+	}
+	if cfg.EmitStorageBinding && (!cfg.Enrichment.Enabled ||
+		!slices.Contains(cfg.Enrichment.Kinds, "PersistentVolumeClaim") && !slices.Contains(cfg.Enrichment.Kinds, "PersistentVolume")) {
+		return errors.New("emit_storage_binding requires enrichment of the PersistentVolumeClaim or PersistentVolume kind")
+	}
+	if cfg.EmitResourceQuota && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "ResourceQuota")) {
+		return errors.New("emit_resource_quota requires enrichment of the ResourceQuota kind")
+	}
+	if err := cfg.WorkloadSelector.Validate(); err != nil {
+		return fmt.Errorf("workload_selector: %w", err)
+	}
+	if len(cfg.WorkloadSelector.Deployments) > 0 && (!cfg.Enrichment.Enabled ||
+		!slices.Contains(cfg.Enrichment.Kinds, "ReplicaSet") || !slices.Contains(cfg.Enrichment.Kinds, "Pod")) {
+		return errors.New("workload_selector requires enrichment of the ReplicaSet and Pod kinds")
+	}
+	if cfg.NamespaceOwnerAnnotation != "" && (!cfg.Enrichment.Enabled || !slices.Contains(cfg.Enrichment.Kinds, "Namespace")) {
+		return errors.New("namespace_owner_annotation requires enrichment of the Namespace kind")
+	}
+	if len(cfg.InvolvedObjectLabelKeys) > 0 && !cfg.Enrichment.Enabled {
+		return errors.New("involved_object_label_keys requires enrichment")
+	}
+	if slices.Contains(cfg.InvolvedObjectLabelKeys, "") {
+		return errors.New("involved_object_label_keys must not contain empty keys")
+	}
+	if slices.Contains(cfg.ParseJSONAnnotations, "") {
+		return errors.New("parse_json_annotations must not contain empty keys")
+	}
+	if cfg.EmitObjectAgeAtEvent && !cfg.Enrichment.Enabled {
+		return errors.New("emit_object_age_at_event requires enrichment")
+	}
+	if cfg.EmitRawObject && !cfg.Enrichment.Enabled {
+		return errors.New("emit_raw_object requires enrichment")
+	}
+	if err := cfg.SeverityText.Validate(); err != nil {
+		return fmt.Errorf("severity_text: %w", err)
+	}
+	if err := cfg.Priority.Validate(); err != nil {
+		return fmt.Errorf("priority: %w", err)
+	}
+	if err := cfg.IncidentSeverity.Validate(); err != nil {
+		return fmt.Errorf("incident_severity: %w", err)
+	}
+	if cfg.Actionable.Enabled && len(cfg.Actionable.Types) == 0 && len(cfg.Actionable.Reasons) == 0 {
+		return errors.New("actionable: types or reasons must be set")
+	}
+	if err := cfg.Maintenance.Validate(); err != nil {
+		return fmt.Errorf("maintenance: %w", err)
+	}
+	if err := cfg.ActiveSchedule.Validate(); err != nil {
+		return fmt.Errorf("active_schedule: %w", err)
+	}
+	if cfg.MinCountGrowthRate.Enabled && cfg.MinCountGrowthRate.PerMinute <= 0 {
+		return errors.New("min_count_growth_rate.per_minute must be positive")
+	}
+	if cfg.MinCountGrowthRate.Enabled && cfg.MinCountGrowthRate.MaxEntries <= 0 {
+		return errors.New("min_count_growth_rate.max_entries must be positive")
+	}
+	if cfg.FirstOccurrenceOnly.Enabled && cfg.FirstOccurrenceOnly.MaxEntries <= 0 {
+		return errors.New("first_occurrence_only.max_entries must be positive")
+	}
+	if cfg.DeadLetterLog.Enabled && cfg.DeadLetterLog.MaxPerMinute <= 0 {
+		return errors.New("dead_letter_log.max_per_minute must be positive")
+	}
+	if cfg.LoadSheddingOnFull.Enabled && cfg.LoadSheddingOnFull.Cooldown <= 0 {
+		return errors.New("load_shedding_on_full.cooldown must be positive")
+	}
+	if err := cfg.AdaptiveThrottle.Validate(); err != nil {
+		return fmt.Errorf("adaptive_throttle: %w", err)
+	}
+	if err := cfg.IncidentGrouping.Validate(); err != nil {
+		return fmt.Errorf("incident_grouping: %w", err)
+	}
+	if cfg.ReasonStreak.Enabled && cfg.ReasonStreak.MaxObjects <= 0 {
+		return errors.New("reason_streak.max_objects must be positive")
+	}
+	if err := cfg.ResolvedEvents.Validate(); err != nil {
+		return fmt.Errorf("resolved_events: %w", err)
+	}
+	if err := cfg.Digest.Validate(); err != nil {
+		return fmt.Errorf("digest: %w", err)
+	}
+	if cfg.DropDigest.Enabled && cfg.DropDigest.Interval <= 0 {
+		return errors.New("drop_digest.interval must be positive")
+	}
+	if err := cfg.CrossNamespaceAggregation.Validate(); err != nil {
+		return fmt.Errorf("cross_namespace_aggregation: %w", err)
+	}
+	if cfg.EventDomainMode && cfg.SourceNamespacedAttributes {
+		return errors.New("event_domain_mode and source_namespaced_attributes are mutually exclusive")
+	}
+	if cfg.CrossNamespaceAggregation.Enabled && cfg.Digest.Enabled {
+		return errors.New("cross_namespace_aggregation and digest are mutually exclusive")
+	}
+	if err := cfg.Routing.Validate(); err != nil {
+		return fmt.Errorf("routing: %w", err)
+	}
 	return cfg.APIConfig.Validate()
 }
 
+func (cfg *EnrichmentConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if len(cfg.Kinds) == 0 {
+		return errors.New("kinds must not be empty")
+	}
+	for _, kind := range cfg.Kinds {
+		if _, ok := cachedKinds[kind]; !ok {
+			return fmt.Errorf("unsupported kind %q", kind)
+		}
+	}
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	switch cfg.Fallback {
+	case enrichmentFallbackEmitWithout, enrichmentFallbackDrop:
+	default:
+		return fmt.Errorf("invalid fallback %q, must be one of %q or %q", cfg.Fallback, enrichmentFallbackEmitWithout, enrichmentFallbackDrop)
+	}
+	if err := cfg.OwnerChainCache.Validate(); err != nil {
+		return fmt.Errorf("owner_chain_cache: %w", err)
+	}
+	return nil
+}
+
+func (cfg *OwnerChainCacheConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.TTL <= 0 {
+		return errors.New("ttl must be positive")
+	}
+	if cfg.MaxEntries <= 0 {
+		return errors.New("max_entries must be positive")
+	}
+	if cfg.MaxConcurrency <= 0 {
+		return errors.New("max_concurrency must be positive")
+	}
+	return nil
+}
+
+func (cfg *AnnotationSelectorConfig) Validate() error {
+	switch cfg.NotCached {
+	case notCachedActionAllow, notCachedActionDrop:
+		return nil
+	default:
+		return fmt.Errorf("invalid not_cached %q, must be one of %q or %q", cfg.NotCached, notCachedActionAllow, notCachedActionDrop)
+	}
+}
+
+func (cfg *WorkloadSelectorConfig) Validate() error {
+	for _, d := range cfg.Deployments {
+		if ns, name, ok := strings.Cut(d, "/"); !ok || ns == "" || name == "" {
+			return fmt.Errorf("invalid deployment %q, must be \"namespace/name\"", d)
+		}
+	}
+	return nil
+}
+
+func (cfg *SeverityTextConfig) Validate() error {
+	for _, m := range []map[string]string{cfg.Types, cfg.Reasons, cfg.Keywords} {
+		for k, text := range m {
+			if _, ok := severityTextNumbers[text]; !ok {
+				return fmt.Errorf("unsupported severity text %q for %q", text, k)
+			}
+		}
+	}
+	if _, ok := cfg.Keywords[""]; ok {
+		return errors.New("keywords must not be empty")
+	}
+	return nil
+}
+
+func (cfg *IncidentSeverityConfig) Validate() error {
+	for reason, severity := range cfg.Reasons {
+		if severity != "" && !incidentSeverities[severity] {
+			return fmt.Errorf("unsupported severity %q for %q, must be one of SEV1, SEV2, SEV3 or SEV4", severity, reason)
+		}
+	}
+	return nil
+}
+
+func (cfg *PriorityConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	w := cfg.Weights
+	if w.Type < 0 || w.Reason < 0 || w.Count < 0 || w.Recency < 0 {
+		return errors.New("weights must not be negative")
+	}
+	if w.Type+w.Reason+w.Count+w.Recency <= 0 {
+		return errors.New("at least one weight must be positive")
+	}
+	if cfg.SaturationCount < 2 {
+		return errors.New("saturation_count must be at least 2")
+	}
+	if cfg.RecencyWindow <= 0 {
+		return errors.New("recency_window must be positive")
+	}
+	return nil
+}
+
+func (cfg *MaintenanceConfig) Validate() error {
+	switch cfg.Action {
+	case maintenanceActionDrop, maintenanceActionFlag:
+	default:
+		return fmt.Errorf("invalid action %q, must be one of %q or %q", cfg.Action, maintenanceActionDrop, maintenanceActionFlag)
+	}
+	for i, w := range cfg.Windows {
+		if !w.End.After(w.Start) {
+			return fmt.Errorf("window %d: end must be after start", i)
+		}
+	}
+	return nil
+}
+
+func (cfg *ActiveScheduleConfig) Validate() error {
+	_, err := cfg.compile()
+	return err
+}
+
+func (cfg *DigestConfig) Validate() error {
+	if cfg.Enabled && cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	return nil
+}
+
+func (cfg *CrossNamespaceAggregationConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if cfg.MaxGroups <= 0 {
+		return errors.New("max_groups must be positive")
+	}
+	return nil
+}
+
+func (cfg *RoutingConfig) Validate() error {
+	for typ, route := range cfg.Routes {
+		if route == "" {
+			return fmt.Errorf("route of type %q must not be empty", typ)
+		}
+	}
+	return nil
+}
+
+func (cfg *LookupFileConfig) Validate() error {
+	if cfg.ReloadInterval < 0 {
+		return errors.New("reload_interval must not be negative")
+	}
+	if cfg.ReloadInterval > 0 && cfg.Path == "" {
+		return errors.New("reload_interval requires a path")
+	}
+	return nil
+}
+
+func (cfg *SuppressionConfigMapConfig) Validate() error {
+	if cfg.Name != "" && cfg.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+	if cfg.Name == "" && cfg.Namespace != "" {
+		return errors.New("name must be set")
+	}
+	return nil
+}
+
+func (cfg *AdaptiveThrottleConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.TargetLatency <= 0 {
+		return errors.New("target_latency must be positive")
+	}
+	if cfg.MinRate <= 0 {
+		return errors.New("min_rate must be positive")
+	}
+	if cfg.MaxRate < cfg.MinRate {
+		return errors.New("max_rate must not be less than min_rate")
+	}
+	return nil
+}
+
+func (cfg *IncidentGroupingConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if cfg.MaxObjects <= 0 {
+		return errors.New("max_objects must be positive")
+	}
+	return nil
+}
+
+func (cfg *ResolvedEventsConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.QuietPeriod <= 0 {
+		return errors.New("quiet_period must be positive")
+	}
+	if cfg.MaxEntries <= 0 {
+		return errors.New("max_entries must be positive")
+	}
+	return nil
+}
+
+// compileRedactions compiles the message redaction patterns.
+func compileRedactions(patterns []string) ([]*regexp.Regexp, error) {
+	redactions := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message_redaction_patterns pattern %q: %w", pattern, err)
+		}
+		redactions = append(redactions, re)
+	}
+	return redactions, nil
+}
+
 func (cfg *Config) getK8sClient() (k8s.Interface, error) {
 	if cfg.makeClient == nil {
 		cfg.makeClient = k8sconfig.MakeClient
 	}
 	return cfg.makeClient(cfg.APIConfig)
 }
+
+// apiServerEndpoint returns the host of the API server the client connects to, without credentials.
+func (cfg *Config) apiServerEndpoint() (string, error) {
+	restConfig, err := k8sconfig.CreateRestConfig(cfg.APIConfig)
+	if err != nil {
+		return "", err
+	}
+	return redactEndpoint(restConfig.Host), nil
+}
+
+// redactEndpoint removes the user info, the query and the fragment from the API server host,
+// which may be a URL or a bare host and port.
+func redactEndpoint(host string) string {
+	if !strings.Contains(host, "://") {
+		host = host[strings.LastIndexByte(host, '@')+1:]
+		host, _, _ = strings.Cut(host, "?")
+		return host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		// Don't risk emitting the credentials of hosts which can't be parsed.
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// getAuthenticatedK8sClient creates a client whose requests to the API server are authenticated by auth.
+func (cfg *Config) getAuthenticatedK8sClient(auth extensionauth.HTTPClient) (k8s.Interface, error) {
+	restConfig, err := k8sconfig.CreateRestConfig(cfg.APIConfig)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := rest.TransportFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	transport, err = auth.RoundTripper(transport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the auth round tripper: %w", err)
+	}
+	return k8s.NewForConfigAndClient(restConfig, &http.Client{Transport: transport})
+}
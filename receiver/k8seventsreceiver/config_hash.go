@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// configHash returns a hash of the settings of cfg filtering and converting the events.
+// The connection to the API server is left out, since it doesn't change the emitted events.
+func (cfg *Config) configHash() (string, error) {
+	c := *cfg
+	c.APIConfig = k8sconfig.APIConfig{}
+	c.Auth = nil
+	c.EmitConfigHash = false
+	// The maps are encoded with sorted keys, so that equal configurations have equal hashes.
+	data, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	h.Write(data)
+	return strconv.FormatUint(h.Sum64(), 16), nil
+}
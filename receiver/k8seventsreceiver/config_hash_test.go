@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestConfigHash(t *testing.T) {
+	hash := func(modify func(cfg *Config)) string {
+		cfg := createDefaultConfig().(*Config)
+		modify(cfg)
+		h, err := cfg.configHash()
+		require.NoError(t, err)
+		return h
+	}
+	base := hash(func(*Config) {})
+	assert.NotEmpty(t, base)
+	assert.Equal(t, base, hash(func(*Config) {}))
+
+	// The connection to the API server and the toggle itself don't change the hash.
+	assert.Equal(t, base, hash(func(cfg *Config) {
+		cfg.AuthType = k8sconfig.AuthTypeKubeConfig
+		cfg.Auth = &configauth.Authentication{}
+		cfg.EmitConfigHash = true
+	}))
+
+	// The filters and the conversion do.
+	filtered := hash(func(cfg *Config) { cfg.EventTypes = []string{"Warning"} })
+	assert.NotEqual(t, base, filtered)
+	assert.NotEqual(t, filtered, hash(func(cfg *Config) { cfg.EventTypes = []string{"Normal"} }))
+	assert.NotEqual(t, base, hash(func(cfg *Config) { cfg.BodyTemplate = "{reason}: {message}" }))
+	assert.NotEqual(t, base, hash(func(cfg *Config) { cfg.Routing.Routes["Warning"] = "urgent" }))
+}
+
+func TestHandleEventWithConfigHash(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EmitConfigHash = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeConfigHash)
+	require.True(t, ok)
+	expected, err := rCfg.configHash()
+	require.NoError(t, err)
+	assert.Equal(t, expected, attr.Str())
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// attributeConsoleURL is the URL of the involved object of the event in the console of the cluster.
+const attributeConsoleURL = "k8s.event.console_url"
+
+// consoleURLFields are the fields of the involved objects the console URL template can be expanded with.
+// The namespace of the cluster scoped objects is the one of the event.
+var consoleURLFields = map[string]func(ev *corev1.Event) string{
+	"namespace": func(ev *corev1.Event) string {
+		if ev.InvolvedObject.Namespace != "" {
+			return ev.InvolvedObject.Namespace
+		}
+		return ev.Namespace
+	},
+	"kind":       func(ev *corev1.Event) string { return ev.InvolvedObject.Kind },
+	"kind_lower": func(ev *corev1.Event) string { return strings.ToLower(ev.InvolvedObject.Kind) },
+	"name":       func(ev *corev1.Event) string { return ev.InvolvedObject.Name },
+	"uid":        func(ev *corev1.Event) string { return string(ev.InvolvedObject.UID) },
+}
+
+// validateConsoleURLTemplate checks that tmpl only refers to known fields.
+func validateConsoleURLTemplate(tmpl string) error {
+	_, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		_, ok := consoleURLFields[name]
+		return "", ok
+	})
+	return err
+}
+
+// eventConsoleURL builds the console URL of the involved object of ev from tmpl,
+// with the fields escaped to be safely used in the path or the query of the URL.
+// It returns false for the events without an involved object.
+func eventConsoleURL(tmpl string, ev *corev1.Event) (string, bool) {
+	if ev.InvolvedObject.Name == "" {
+		return "", false
+	}
+	u, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		f, ok := consoleURLFields[name]
+		if !ok {
+			return "", false
+		}
+		return url.PathEscape(f(ev)), true
+	})
+	if err != nil {
+		// The template is validated, this only happens for configurations built in code.
+		return "", false
+	}
+	return u, true
+}
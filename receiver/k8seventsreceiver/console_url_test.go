@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestEventConsoleURL(t *testing.T) {
+	k8sEvent := getEvent()
+
+	tests := []struct {
+		tmpl     string
+		expected string
+	}{
+		{tmpl: "https://dashboard.example.com/#/{kind_lower}/{namespace}/{name}", expected: "https://dashboard.example.com/#/pod/test/test-34bcd-rn54"},
+		{tmpl: "https://console.example.com/k8s/ns/{namespace}/{kind}s/{name}?uid={uid}", expected: "https://console.example.com/k8s/ns/test/Pods/test-34bcd-rn54?uid=059f3edc-b5a9"},
+		{tmpl: "https://console.example.com/", expected: "https://console.example.com/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			assert.NoError(t, validateConsoleURLTemplate(tt.tmpl))
+			consoleURL, ok := eventConsoleURL(tt.tmpl, k8sEvent)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, consoleURL)
+		})
+	}
+}
+
+func TestEventConsoleURLEscaping(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.Name = "web 1/2"
+	consoleURL, ok := eventConsoleURL("https://console.example.com/{namespace}/{name}", k8sEvent)
+	require.True(t, ok)
+	assert.Equal(t, "https://console.example.com/test/web%201%2F2", consoleURL)
+}
+
+func TestEventConsoleURLClusterScoped(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.Namespace = "default"
+	k8sEvent.InvolvedObject = corev1.ObjectReference{Kind: "Node", Name: "node-1"}
+	consoleURL, ok := eventConsoleURL("https://console.example.com/{namespace}/{kind_lower}/{name}", k8sEvent)
+	require.True(t, ok)
+	assert.Equal(t, "https://console.example.com/default/node/node-1", consoleURL)
+
+	k8sEvent.InvolvedObject = corev1.ObjectReference{}
+	_, ok = eventConsoleURL("https://console.example.com/{namespace}/{kind_lower}/{name}", k8sEvent)
+	assert.False(t, ok)
+}
+
+func TestValidateConsoleURLTemplate(t *testing.T) {
+	assert.EqualError(t, validateConsoleURLTemplate("https://console.example.com/{object_name}"), `unknown field "object_name"`)
+}
+
+func TestK8sEventToLogDataWithConsoleURL(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	k8sEvent := getEvent()
+
+	lr := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, ok := lr.Attributes().Get(attributeConsoleURL)
+	assert.False(t, ok)
+
+	cfg.ConsoleURLTemplate = "https://dashboard.example.com/#/{kind_lower}/{namespace}/{name}"
+	lr = k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	consoleURL, ok := lr.Attributes().Get(attributeConsoleURL)
+	require.True(t, ok)
+	assert.Equal(t, "https://dashboard.example.com/#/pod/test/test-34bcd-rn54", consoleURL.Str())
+}
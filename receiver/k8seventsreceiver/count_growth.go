@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// countObservation is the last count of an event and the time it was last seen at.
+type countObservation struct {
+	uid   types.UID
+	count int32
+	last  time.Time
+}
+
+// countGrowthTracker remembers the last count of the events, up to maxEntries,
+// forgetting the least recently observed ones first when full.
+type countGrowthTracker struct {
+	maxEntries int
+
+	mu    sync.Mutex
+	seen  map[types.UID]*list.Element
+	order *list.List
+}
+
+func newCountGrowthTracker(maxEntries int) *countGrowthTracker {
+	return &countGrowthTracker{
+		maxEntries: maxEntries,
+		seen:       make(map[types.UID]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// observe records the count of the event uid last seen at last, and returns the growth of its count in
+// events per minute since its previous observation, or since its first occurrence when observed for the
+// first time. The intervals between the observations are at least a second, so that the growth stays bounded.
+func (t *countGrowthTracker) observe(uid types.UID, count int32, first, last time.Time) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.seen[uid]
+	if !ok {
+		if t.order.Len() >= t.maxEntries {
+			oldest := t.order.Front()
+			t.order.Remove(oldest)
+			delete(t.seen, oldest.Value.(*countObservation).uid)
+		}
+		t.seen[uid] = t.order.PushBack(&countObservation{uid: uid, count: count, last: last})
+		return countGrowthSinceFirst(count, first, last)
+	}
+	t.order.MoveToBack(e)
+	o := e.Value.(*countObservation)
+	growth := 0.0
+	if count > o.count {
+		minutes := max(last.Sub(o.last).Minutes(), time.Second.Minutes())
+		growth = float64(count-o.count) / minutes
+	}
+	o.count, o.last = count, last
+	return growth
+}
+
+// countGrowthSinceFirst returns the growth of count in events per minute over the span between the first
+// and the last occurrences of an event, of at least a minute.
+func countGrowthSinceFirst(count int32, first, last time.Time) float64 {
+	minutes := 1.0
+	if !first.IsZero() && !last.IsZero() {
+		minutes = max(minutes, last.Sub(first).Minutes())
+	}
+	return float64(count) / minutes
+}
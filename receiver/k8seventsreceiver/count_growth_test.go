@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCountGrowthTracker(t *testing.T) {
+	first := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	tr := newCountGrowthTracker(10)
+
+	// The growth of a new event is its count since its first occurrence, over at least a minute.
+	assert.InDelta(t, 2.0, tr.observe("fast", 20, first, first.Add(10*time.Minute)), 1e-9)
+	assert.InDelta(t, 3.0, tr.observe("new", 3, first, first.Add(10*time.Second)), 1e-9)
+	assert.InDelta(t, 3.0, tr.observe("unknown", 3, time.Time{}, time.Time{}), 1e-9)
+
+	// The growth of a known event is its count increase since its previous observation.
+	assert.InDelta(t, 30.0, tr.observe("fast", 50, first, first.Add(11*time.Minute)), 1e-9)
+	assert.InDelta(t, 2.0, tr.observe("fast", 51, first, first.Add(11*time.Minute+30*time.Second)), 1e-9)
+	// The stable counts don't grow, and the intervals are at least a second.
+	assert.Zero(t, tr.observe("fast", 51, first, first.Add(12*time.Minute)))
+	assert.InDelta(t, 60.0, tr.observe("fast", 52, first, first.Add(12*time.Minute)), 1e-9)
+}
+
+func TestCountGrowthTrackerEviction(t *testing.T) {
+	first := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	tr := newCountGrowthTracker(2)
+	tr.observe("a", 10, first, first.Add(10*time.Minute))
+	tr.observe("b", 10, first, first.Add(10*time.Minute))
+	// a is observed again, b is the least recently observed.
+	tr.observe("a", 10, first, first.Add(11*time.Minute))
+	tr.observe("c", 10, first, first.Add(10*time.Minute))
+
+	assert.Len(t, tr.seen, 2)
+	assert.NotContains(t, tr.seen, types.UID("b"))
+	// Forgotten events are tracked again as new ones.
+	assert.InDelta(t, 1.0, tr.observe("b", 10, first, first.Add(10*time.Minute)), 1e-9)
+}
+
+func TestHandleEventWithMinCountGrowthRate(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.MinCountGrowthRate.Enabled = true
+	rCfg.MinCountGrowthRate.PerMinute = 5
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	first := time.Now().Add(time.Minute)
+	update := func(name string, count int32, last time.Duration) {
+		k8sEvent := getEvent()
+		k8sEvent.UID = types.UID("uid-" + name)
+		k8sEvent.Name = name
+		k8sEvent.Count = count
+		k8sEvent.FirstTimestamp = v1.NewTime(first)
+		k8sEvent.LastTimestamp = v1.NewTime(first.Add(last))
+		recv.handleEvent(k8sEvent)
+	}
+
+	// The count of the escalating event grows fast.
+	update("escalating", 10, time.Minute)
+	update("escalating", 20, 2*time.Minute)
+	update("escalating", 60, 3*time.Minute)
+	assert.Equal(t, 3, sink.LogRecordCount())
+
+	// The count of the slow event grows slowly, and then stops growing.
+	sink.Reset()
+	update("slow", 2, 5*time.Minute)
+	update("slow", 3, 6*time.Minute)
+	update("slow", 3, 7*time.Minute)
+	assert.Equal(t, 0, sink.LogRecordCount())
+	assert.Equal(t, map[string]int64{dropReasonCountGrowthRate: 3}, recv.stats.dropped)
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// deadLetterLogger records the events lost since they failed to be consumed in the logs of the
+// collector, rate-limited so that a failing pipeline doesn't flood the logs of the collector.
+type deadLetterLogger struct {
+	logger  *zap.Logger
+	limiter *rate.Limiter
+}
+
+func newDeadLetterLogger(logger *zap.Logger, maxPerMinute int) *deadLetterLogger {
+	return &deadLetterLogger{
+		logger:  logger,
+		limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(maxPerMinute)), maxPerMinute),
+	}
+}
+
+// log records the event ev lost with the error err, unless the rate limit is exceeded.
+func (d *deadLetterLogger) log(ev *corev1.Event, err error) {
+	if !d.limiter.Allow() {
+		return
+	}
+	d.logger.Error("dropping the event which failed to be consumed",
+		zap.String("uid", string(ev.UID)),
+		zap.String("namespace", ev.Namespace),
+		zap.String("name", ev.Name),
+		zap.String("reason", ev.Reason),
+		zap.String("type", ev.Type),
+		zap.String("involved_object_kind", ev.InvolvedObject.Kind),
+		zap.String("involved_object_name", ev.InvolvedObject.Name),
+		zap.Time("timestamp", getEventTimestamp(ev)),
+		zap.Error(err),
+	)
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDeadLetterLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.ErrorLevel)
+	d := newDeadLetterLogger(zap.New(core), 2)
+
+	for i := 0; i < 5; i++ {
+		d.log(getEvent(), errors.New("queue is full"))
+	}
+
+	// The logs beyond the rate limit are skipped.
+	require.Equal(t, 2, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "289686f9-a5c0", fields["uid"])
+	assert.Equal(t, "testing_event_1", fields["reason"])
+	assert.Equal(t, "Pod", fields["involved_object_kind"])
+	assert.Equal(t, "test-34bcd-rn54", fields["involved_object_name"])
+	assert.Equal(t, "queue is full", fields["error"])
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// debouncer coalesces the updates of the same event delivered within a window,
+// handling only the latest update once the window following the first one expires.
+type debouncer struct {
+	window time.Duration
+	handle func(*corev1.Event)
+
+	mu      sync.Mutex
+	pending map[types.UID]*pendingUpdate
+}
+
+// pendingUpdate is the latest update of an event waiting for its window to expire.
+type pendingUpdate struct {
+	ev    *corev1.Event
+	timer *time.Timer
+	done  bool
+}
+
+func newDebouncer(window time.Duration, handle func(*corev1.Event)) *debouncer {
+	return &debouncer{
+		window:  window,
+		handle:  handle,
+		pending: make(map[types.UID]*pendingUpdate),
+	}
+}
+
+// update records ev as the latest update of the event, starting its window if none is running.
+func (d *debouncer) update(ev *corev1.Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.pending[ev.UID]; ok {
+		p.ev = ev
+		return
+	}
+	p := &pendingUpdate{ev: ev}
+	p.timer = time.AfterFunc(d.window, func() { d.fire(ev.UID, p) })
+	d.pending[ev.UID] = p
+}
+
+func (d *debouncer) fire(uid types.UID, p *pendingUpdate) {
+	d.mu.Lock()
+	if p.done {
+		d.mu.Unlock()
+		return
+	}
+	p.done = true
+	delete(d.pending, uid)
+	ev := p.ev
+	d.mu.Unlock()
+	d.handle(ev)
+}
+
+// flush handles all the pending updates immediately, without waiting for their windows to expire.
+func (d *debouncer) flush() {
+	d.mu.Lock()
+	pending := make([]*corev1.Event, 0, len(d.pending))
+	for uid, p := range d.pending {
+		p.timer.Stop()
+		p.done = true
+		pending = append(pending, p.ev)
+		delete(d.pending, uid)
+	}
+	d.mu.Unlock()
+	for _, ev := range pending {
+		d.handle(ev)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// handledEvents records the events handled by a debouncer.
+type handledEvents struct {
+	mu     sync.Mutex
+	events []*corev1.Event
+}
+
+func (h *handledEvents) handle(ev *corev1.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, ev)
+}
+
+func (h *handledEvents) get() []*corev1.Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*corev1.Event(nil), h.events...)
+}
+
+func TestDebouncer(t *testing.T) {
+	var handled handledEvents
+	d := newDebouncer(50*time.Millisecond, handled.handle)
+
+	for count := int32(2); count <= 4; count++ {
+		ev := getEvent()
+		ev.Count = count
+		d.update(ev)
+	}
+	other := getEvent()
+	other.UID = types.UID("7c3b-91ea")
+	d.update(other)
+
+	assert.Eventually(t, func() bool {
+		return len(handled.get()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	counts := map[types.UID]int32{}
+	for _, ev := range handled.get() {
+		counts[ev.UID] = ev.Count
+	}
+	assert.Equal(t, map[types.UID]int32{"289686f9-a5c0": 4, "7c3b-91ea": 2}, counts)
+
+	// A new window starts with the next update.
+	ev := getEvent()
+	ev.Count = 5
+	d.update(ev)
+	assert.Eventually(t, func() bool {
+		return len(handled.get()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(5), handled.get()[2].Count)
+}
+
+func TestDebouncerFlush(t *testing.T) {
+	var handled handledEvents
+	d := newDebouncer(time.Hour, handled.handle)
+
+	d.update(getEvent())
+	assert.Empty(t, handled.get())
+	d.flush()
+	require.Len(t, handled.get(), 1)
+
+	// Flushed updates are not handled again.
+	d.flush()
+	assert.Len(t, handled.get(), 1)
+}
+
+func TestShutdownFlushesDebouncedUpdates(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.UpdateDebounce = time.Hour
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NotNil(t, recv.debouncer)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+
+	recv.debouncer.update(getEvent())
+	assert.Equal(t, 0, sink.LogRecordCount())
+	require.NoError(t, recv.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
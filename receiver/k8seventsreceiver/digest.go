@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// attributeDigestCount is the number of events of a reason and a type counted in a digest.
+	attributeDigestCount = "k8s.event.digest.count"
+
+	// attributeDigestIntervalStart is the start of the interval of a digest.
+	attributeDigestIntervalStart = "k8s.event.digest.interval_start"
+)
+
+// digestKey identifies the events counted together in a digest.
+type digestKey struct {
+	namespace string
+	reason    string
+	typ       string
+}
+
+// digest counts the events by namespace, reason and type over an interval,
+// to be emitted as one log per count instead of one log per event.
+type digest struct {
+	mu     sync.Mutex
+	start  time.Time
+	counts map[digestKey]int64
+}
+
+func newDigest(start time.Time) *digest {
+	return &digest{start: start, counts: make(map[digestKey]int64)}
+}
+
+// add counts the event ev.
+func (d *digest) add(ev *corev1.Event) {
+	namespace := ev.InvolvedObject.Namespace
+	if namespace == "" {
+		namespace = ev.Namespace
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.counts[digestKey{namespace: namespace, reason: ev.Reason, typ: ev.Type}]++
+}
+
+// flush builds the logs of the counts of the interval ending at now, one resource per namespace,
+// and resets the counts for the next interval. ok is false when no events were counted.
+func (d *digest) flush(now time.Time) (ld plog.Logs, ok bool) {
+	d.mu.Lock()
+	counts, start := d.counts, d.start
+	d.counts, d.start = make(map[digestKey]int64), now
+	d.mu.Unlock()
+	if len(counts) == 0 {
+		return plog.Logs{}, false
+	}
+
+	keys := make([]digestKey, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b digestKey) int {
+		return cmp.Or(cmp.Compare(a.namespace, b.namespace), cmp.Compare(a.reason, b.reason), cmp.Compare(a.typ, b.typ))
+	})
+
+	ld = plog.NewLogs()
+	var lrs plog.LogRecordSlice
+	for i, k := range keys {
+		if i == 0 || k.namespace != keys[i-1].namespace {
+			rl := ld.ResourceLogs().AppendEmpty()
+			if k.namespace != "" {
+				rl.Resource().Attributes().PutStr(semconv.AttributeK8SNamespaceName, k.namespace)
+			}
+			lrs = rl.ScopeLogs().AppendEmpty().LogRecords()
+		}
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+		if severityNumber, ok := severityMap[strings.ToLower(k.typ)]; ok {
+			lr.SetSeverityNumber(severityNumber)
+			lr.SetSeverityText(k.typ)
+		}
+		lr.Body().SetStr("Kubernetes events digest")
+		attrs := lr.Attributes()
+		attrs.PutStr("k8s.event.reason", k.reason)
+		attrs.PutStr("k8s.event.type", k.typ)
+		attrs.PutInt(attributeDigestCount, counts[k])
+		attrs.PutStr(attributeDigestIntervalStart, start.UTC().Format(time.RFC3339))
+	}
+	return ld, true
+}
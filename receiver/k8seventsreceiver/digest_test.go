@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestDigestFlush(t *testing.T) {
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	d := newDigest(start)
+	// The intervals without events are skipped.
+	_, ok := d.flush(start.Add(time.Minute))
+	assert.False(t, ok)
+
+	backOff := getEvent()
+	backOff.Reason = "BackOff"
+	backOff.Type = "Warning"
+	d.add(backOff)
+	d.add(backOff)
+	d.add(getEvent())
+	node := getEvent()
+	node.InvolvedObject = corev1.ObjectReference{Kind: "Node", Name: "node-1"}
+	node.Namespace = ""
+	d.add(node)
+
+	now := start.Add(2 * time.Minute)
+	ld, ok := d.flush(now)
+	require.True(t, ok)
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+
+	// The cluster scoped events come first, without a namespace.
+	cluster := ld.ResourceLogs().At(0)
+	assert.Empty(t, cluster.Resource().Attributes().AsRaw())
+	lr := cluster.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]any{
+		"k8s.event.reason":           "testing_event_1",
+		"k8s.event.type":             "Normal",
+		attributeDigestCount:         int64(1),
+		attributeDigestIntervalStart: "2025-03-01T10:01:00Z",
+	}, lr.Attributes().AsRaw())
+
+	namespaced := ld.ResourceLogs().At(1)
+	assert.Equal(t, map[string]any{"k8s.namespace.name": "test"}, namespaced.Resource().Attributes().AsRaw())
+	lrs := namespaced.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	assert.Equal(t, "BackOff", lrs.At(0).Attributes().AsRaw()["k8s.event.reason"])
+	assert.Equal(t, int64(2), lrs.At(0).Attributes().AsRaw()[attributeDigestCount])
+	assert.Equal(t, "Warning", lrs.At(0).SeverityText())
+	assert.Equal(t, now, lrs.At(0).Timestamp().AsTime())
+	assert.Equal(t, "testing_event_1", lrs.At(1).Attributes().AsRaw()["k8s.event.reason"])
+	assert.Equal(t, int64(1), lrs.At(1).Attributes().AsRaw()[attributeDigestCount])
+
+	// The counts are reset after a flush, the next interval starting at the flush.
+	_, ok = d.flush(now.Add(time.Minute))
+	assert.False(t, ok)
+	d.add(backOff)
+	ld, ok = d.flush(now.Add(2 * time.Minute))
+	require.True(t, ok)
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, int64(1), lr.Attributes().AsRaw()[attributeDigestCount])
+	assert.Equal(t, "2025-03-01T10:03:00Z", lr.Attributes().AsRaw()[attributeDigestIntervalStart])
+}
+
+func TestDigestEmission(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Digest.Enabled = true
+	rCfg.Digest.Interval = 50 * time.Millisecond
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	// The events are counted before the start, so that they fall in the first interval.
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, int64(2), lr.Attributes().AsRaw()[attributeDigestCount])
+
+	// The events counted since the last digest are emitted on shutdown.
+	recv.handleEvent(getEvent())
+	require.NoError(t, recv.Shutdown(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	lr = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, int64(1), lr.Attributes().AsRaw()[attributeDigestCount])
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventFromEventsV1 converts an event of the `events.k8s.io/v1` API to the `v1` API, the same way the
+// API server does, so that the events are emitted identically regardless of the API version they are
+// read from: the note becomes the message, the regarded object the involved object, and the
+// deprecated fields are carried over along with the series. The API version of the converted event
+// records the API it was read from.
+func eventFromEventsV1(ev *eventsv1.Event) *corev1.Event {
+	out := &corev1.Event{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: eventsv1.SchemeGroupVersion.String(),
+			Kind:       "Event",
+		},
+		ObjectMeta:          ev.ObjectMeta,
+		InvolvedObject:      ev.Regarding,
+		Reason:              ev.Reason,
+		Message:             ev.Note,
+		Type:                ev.Type,
+		Action:              ev.Action,
+		Related:             ev.Related,
+		ReportingController: ev.ReportingController,
+		ReportingInstance:   ev.ReportingInstance,
+		EventTime:           ev.EventTime,
+		Source: corev1.EventSource{
+			Component: ev.DeprecatedSource.Component,
+			Host:      ev.DeprecatedSource.Host,
+		},
+		FirstTimestamp: ev.DeprecatedFirstTimestamp,
+		LastTimestamp:  ev.DeprecatedLastTimestamp,
+		Count:          ev.DeprecatedCount,
+	}
+	if ev.Series != nil {
+		out.Series = &corev1.EventSeries{
+			Count:            ev.Series.Count,
+			LastObservedTime: ev.Series.LastObservedTime,
+		}
+	}
+	return out
+}
+
+// seriesPosition returns the number of occurrences of an event of the `events.k8s.io` series model,
+// and whether it continues a series, i.e. whether it is an update of an event already reported.
+// The first occurrence of an event has no series, which is only set once the event recurs.
+// The events of the legacy model, which have no event time, are not positioned.
+func seriesPosition(ev *corev1.Event) (count int32, continuation, ok bool) {
+	if ev.Series != nil {
+		return ev.Series.Count, true, true
+	}
+	if ev.EventTime.IsZero() {
+		return 0, false, false
+	}
+	return 1, false, true
+}
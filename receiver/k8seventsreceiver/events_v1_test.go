@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestEventFromEventsV1(t *testing.T) {
+	eventTime := metav1.NewMicroTime(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	lastObserved := metav1.NewMicroTime(eventTime.Add(5 * time.Minute))
+	meta := metav1.ObjectMeta{Name: "web-1.1826a", Namespace: "test", UID: types.UID("289686f9-a5c0")}
+	object := corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "test", UID: types.UID("059f3edc-b5a9")}
+
+	core := &corev1.Event{
+		ObjectMeta:          meta,
+		InvolvedObject:      object,
+		Reason:              "BackOff",
+		Message:             "Back-off restarting failed container",
+		Type:                "Warning",
+		Action:              "Restarting",
+		ReportingController: "kubelet",
+		ReportingInstance:   "node-1",
+		EventTime:           eventTime,
+		Series:              &corev1.EventSeries{Count: 4, LastObservedTime: lastObserved},
+	}
+	events := &eventsv1.Event{
+		ObjectMeta:          meta,
+		Regarding:           object,
+		Reason:              "BackOff",
+		Note:                "Back-off restarting failed container",
+		Type:                "Warning",
+		Action:              "Restarting",
+		ReportingController: "kubelet",
+		ReportingInstance:   "node-1",
+		EventTime:           eventTime,
+		Series:              &eventsv1.EventSeries{Count: 4, LastObservedTime: lastObserved},
+	}
+	converted := eventFromEventsV1(events)
+	assert.Equal(t, metav1.TypeMeta{APIVersion: "events.k8s.io/v1", Kind: "Event"}, converted.TypeMeta)
+	converted.TypeMeta = metav1.TypeMeta{}
+	assert.Equal(t, core, converted)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.EmitMessageAttribute = true
+	cfg.EmitSeenTimestamps = true
+	recv := newTestReceiver(t, cfg, consumertest.NewNop())
+	fromCore, ok := recv.eventFromObject(core)
+	require.True(t, ok)
+	fromEvents, ok := recv.eventFromObject(events)
+	require.True(t, ok)
+
+	expected := k8sEventToLogData(zap.NewNop(), fromCore, cfg, nil)
+	actual := k8sEventToLogData(zap.NewNop(), fromEvents, cfg, nil)
+	lr := actual.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, expected.ResourceLogs().At(0).Resource().Attributes().AsRaw(), actual.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, expected.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw(), lr.Attributes().AsRaw())
+	assert.Equal(t, "Back-off restarting failed container", lr.Body().Str())
+	message, ok := lr.Attributes().Get(attributeMessage)
+	require.True(t, ok)
+	assert.Equal(t, "Back-off restarting failed container", message.Str())
+	action, ok := lr.Attributes().Get("k8s.event.action")
+	require.True(t, ok)
+	assert.Equal(t, "Restarting", action.Str())
+}
+
+func TestEventFromEventsV1DeprecatedFields(t *testing.T) {
+	first := metav1.NewTime(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	last := metav1.NewTime(first.Add(time.Minute))
+	ev := eventFromEventsV1(&eventsv1.Event{
+		Note:                     "Started container",
+		DeprecatedSource:         corev1.EventSource{Component: "kubelet", Host: "node-1"},
+		DeprecatedFirstTimestamp: first,
+		DeprecatedLastTimestamp:  last,
+		DeprecatedCount:          2,
+	})
+	assert.Equal(t, corev1.EventSource{Component: "kubelet", Host: "node-1"}, ev.Source)
+	assert.Equal(t, first, ev.FirstTimestamp)
+	assert.Equal(t, last, ev.LastTimestamp)
+	assert.Equal(t, int32(2), ev.Count)
+	assert.Nil(t, ev.Series)
+}
+
+func TestEventFromEventsV1SeriesPosition(t *testing.T) {
+	eventTime := metav1.NewMicroTime(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	cfg := createDefaultConfig().(*Config)
+	cfg.EmitSeriesPosition = true
+
+	tests := []struct {
+		name         string
+		event        *eventsv1.Event
+		count        int64
+		continuation bool
+	}{
+		{
+			name:  "first_occurrence",
+			event: &eventsv1.Event{Reason: "BackOff", EventTime: eventTime},
+			count: 1,
+		},
+		{
+			name: "continuation",
+			event: &eventsv1.Event{
+				Reason:    "BackOff",
+				EventTime: eventTime,
+				Series:    &eventsv1.EventSeries{Count: 4, LastObservedTime: metav1.NewMicroTime(eventTime.Add(time.Minute))},
+			},
+			count:        4,
+			continuation: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := k8sEventToLogData(zap.NewNop(), eventFromEventsV1(tt.event), cfg, nil)
+			attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			count, ok := attrs.Get(attributeSeriesCount)
+			require.True(t, ok)
+			assert.Equal(t, tt.count, count.Int())
+			continuation, ok := attrs.Get(attributeSeriesContinuation)
+			require.True(t, ok)
+			assert.Equal(t, tt.continuation, continuation.Bool())
+		})
+	}
+
+	// The events of the legacy model aren't positioned.
+	ld := k8sEventToLogData(zap.NewNop(), &corev1.Event{Reason: "BackOff", Count: 3}, cfg, nil)
+	attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	_, ok := attrs.Get(attributeSeriesCount)
+	assert.False(t, ok)
+	_, ok = attrs.Get(attributeSeriesContinuation)
+	assert.False(t, ok)
+}
+
+func TestLogRecordTimestampPreference(t *testing.T) {
+	eventTime := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	lastTimestamp := eventTime.Add(time.Minute)
+	lastObserved := eventTime.Add(2 * time.Minute)
+	core := &corev1.Event{
+		Reason:        "BackOff",
+		EventTime:     metav1.NewMicroTime(eventTime),
+		LastTimestamp: metav1.NewTime(lastTimestamp),
+	}
+	events := eventFromEventsV1(&eventsv1.Event{
+		Reason:                  "BackOff",
+		EventTime:               metav1.NewMicroTime(eventTime),
+		DeprecatedLastTimestamp: metav1.NewTime(lastTimestamp),
+		Series:                  &eventsv1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(lastObserved)},
+	})
+
+	tests := []struct {
+		name     string
+		core     []string
+		events   []string
+		expected map[string]time.Time
+	}{
+		{
+			name:     "default",
+			expected: map[string]time.Time{"core": eventTime, "events": eventTime},
+		},
+		{
+			name:     "per_api",
+			core:     []string{"lastTimestamp", "eventTime"},
+			events:   []string{"series.lastObservedTime", "eventTime"},
+			expected: map[string]time.Time{"core": lastTimestamp, "events": lastObserved},
+		},
+		{
+			name:     "fallback_to_next_field",
+			core:     []string{"series.lastObservedTime", "lastTimestamp"},
+			events:   []string{"deprecatedFirstTimestamp", "deprecatedLastTimestamp"},
+			expected: map[string]time.Time{"core": lastTimestamp, "events": lastTimestamp},
+		},
+		{
+			name:     "fallback_to_event_time",
+			core:     []string{"firstTimestamp"},
+			events:   []string{"deprecatedFirstTimestamp"},
+			expected: map[string]time.Time{"core": eventTime, "events": eventTime},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.CoreTimestampPreference = tt.core
+			cfg.EventsAPITimestampPreference = tt.events
+			require.NoError(t, cfg.Validate())
+			for api, ev := range map[string]*corev1.Event{"core": core, "events": events} {
+				ld := k8sEventToLogData(zap.NewNop(), ev, cfg, nil)
+				lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+				assert.Equal(t, tt.expected[api], lr.Timestamp().AsTime(), api)
+			}
+		})
+	}
+}
+
+func TestStartWithEventsAPIWatch(t *testing.T) {
+	eventTime := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	lastObserved := eventTime.Add(time.Minute)
+	coreEvent := getEvent()
+	coreEvent.FirstTimestamp = metav1.NewTime(eventTime)
+	client := fake.NewClientset(
+		&eventsv1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1.1826a", Namespace: "test", UID: types.UID("289686f9-a5c0")},
+			Regarding:  corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "test"},
+			Reason:     "BackOff",
+			Note:       "Back-off restarting failed container",
+			Type:       corev1.EventTypeWarning,
+			EventTime:  metav1.NewMicroTime(eventTime),
+			Series:     &eventsv1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(lastObserved)},
+		},
+		// The events of the v1 API aren't watched.
+		coreEvent,
+	)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.WatchAPI = watchAPIEvents
+	rCfg.CoreTimestampPreference = []string{"eventTime"}
+	rCfg.EventsAPITimestampPreference = []string{"series.lastObservedTime"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	// Let a wrongly watched v1 event show up.
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "Back-off restarting failed container", lr.Body().Str())
+	assert.True(t, lastObserved.Equal(lr.Timestamp().AsTime()))
+}
@@ -5,6 +5,7 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -14,6 +15,61 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadata"
 )
 
+const (
+	// defaultCollectorNamespaceEnvVar is the environment variable commonly
+	// populated with the pod namespace through the downward API.
+	defaultCollectorNamespaceEnvVar = "POD_NAMESPACE"
+
+	// defaultBodyTemplate sets the message of the events as the body of the log records.
+	defaultBodyTemplate = "{message}"
+
+	// defaultIncidentWindow is the quiet period closing the incidents,
+	// long enough to group the restarts of a crash looping container.
+	defaultIncidentWindow     = 5 * time.Minute
+	defaultIncidentMaxObjects = 10000
+
+	defaultFirstOccurrenceMaxEntries = 10000
+
+	defaultCountGrowthMaxEntries = 10000
+
+	defaultEnrichmentTimeout = 500 * time.Millisecond
+
+	defaultOwnerChainCacheTTL            = time.Minute
+	defaultOwnerChainCacheMaxEntries     = 10000
+	defaultOwnerChainCacheMaxConcurrency = 8
+
+	defaultDeadLetterMaxPerMinute = 10
+
+	defaultDigestInterval = time.Minute
+
+	defaultDropDigestInterval = time.Minute
+
+	defaultAggregationWindow    = time.Minute
+	defaultAggregationMaxGroups = 1000
+
+	defaultLoadSheddingCooldown = 30 * time.Second
+
+	defaultThrottleTargetLatency = 500 * time.Millisecond
+	defaultThrottleMinRate       = 10
+	defaultThrottleMaxRate       = 1000
+
+	defaultReasonStreakMaxObjects = 10000
+
+	defaultTimestampDiscrepancyThreshold = time.Minute
+
+	defaultResolvedQuietPeriod = 10 * time.Minute
+	defaultResolvedMaxEntries  = 10000
+
+	// The default priority weights favor the Warning events and the critical reasons,
+	// and then the recurring and recent events.
+	defaultPriorityTypeWeight    = 40
+	defaultPriorityReasonWeight  = 30
+	defaultPriorityCountWeight   = 15
+	defaultPriorityRecencyWeight = 15
+	defaultPrioritySaturation    = 100
+	defaultPriorityRecencyWindow = time.Hour
+)
+
 // NewFactory creates a factory for k8s_cluster receiver.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
@@ -27,6 +83,115 @@ func createDefaultConfig() component.Config {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		CollectorNamespace: CollectorNamespaceConfig{
+			EnvVar: defaultCollectorNamespaceEnvVar,
+		},
+		WatchAPI:             watchAPICore,
+		ConsistentSampleRate: 1,
+		TimestampPrecision:   "ns",
+		NormalizeCase:        normalizeCaseNone,
+		BodyTemplate:         defaultBodyTemplate,
+		SeverityText: SeverityTextConfig{
+			Types: map[string]string{
+				"Normal":  "INFO",
+				"Warning": "WARNING",
+			},
+			Reasons: map[string]string{
+				"Failed":           "ERROR",
+				"BackOff":          "ERROR",
+				"FailedScheduling": "ERROR",
+				"FailedMount":      "ERROR",
+				"Evicted":          "ERROR",
+				"OOMKilling":       "CRITICAL",
+				"NodeNotReady":     "CRITICAL",
+			},
+		},
+		Priority: PriorityConfig{
+			Weights: PriorityWeightsConfig{
+				Type:    defaultPriorityTypeWeight,
+				Reason:  defaultPriorityReasonWeight,
+				Count:   defaultPriorityCountWeight,
+				Recency: defaultPriorityRecencyWeight,
+			},
+			CriticalReasons: []string{
+				"Failed", "BackOff", "FailedScheduling", "FailedMount", "Evicted", "OOMKilling", "NodeNotReady", "Unhealthy",
+			},
+			SaturationCount: defaultPrioritySaturation,
+			RecencyWindow:   defaultPriorityRecencyWindow,
+		},
+		Actionable: ActionableConfig{
+			Types: []string{"Warning"},
+			Reasons: []string{
+				"FailedScheduling", "FailedMount", "FailedCreate", "Evicted", "OOMKilling", "NodeNotReady",
+			},
+			InformationalReasons: []string{"DNSConfigForming", "ProbeWarning"},
+		},
+		TimestampDiscrepancy: TimestampDiscrepancyConfig{
+			Threshold: defaultTimestampDiscrepancyThreshold,
+		},
+		FailedScheduling: FailedSchedulingConfig{
+			MaxReasons: 3,
+		},
+		Enrichment: EnrichmentConfig{
+			Kinds:    []string{"Pod"},
+			Timeout:  defaultEnrichmentTimeout,
+			Fallback: enrichmentFallbackEmitWithout,
+			OwnerChainCache: OwnerChainCacheConfig{
+				TTL:            defaultOwnerChainCacheTTL,
+				MaxEntries:     defaultOwnerChainCacheMaxEntries,
+				MaxConcurrency: defaultOwnerChainCacheMaxConcurrency,
+			},
+		},
+		InvolvedObjectAnnotationSelector: AnnotationSelectorConfig{
+			NotCached: notCachedActionDrop,
+		},
+		Maintenance: MaintenanceConfig{
+			Action: maintenanceActionDrop,
+		},
+		MinCountGrowthRate: CountGrowthRateConfig{
+			MaxEntries: defaultCountGrowthMaxEntries,
+		},
+		FirstOccurrenceOnly: FirstOccurrenceConfig{
+			MaxEntries: defaultFirstOccurrenceMaxEntries,
+		},
+		DeadLetterLog: DeadLetterLogConfig{
+			MaxPerMinute: defaultDeadLetterMaxPerMinute,
+		},
+		LoadSheddingOnFull: LoadSheddingConfig{
+			Cooldown: defaultLoadSheddingCooldown,
+		},
+		AdaptiveThrottle: AdaptiveThrottleConfig{
+			TargetLatency: defaultThrottleTargetLatency,
+			MinRate:       defaultThrottleMinRate,
+			MaxRate:       defaultThrottleMaxRate,
+		},
+		ReasonStreak: ReasonStreakConfig{
+			MaxObjects: defaultReasonStreakMaxObjects,
+		},
+		ResolvedEvents: ResolvedEventsConfig{
+			QuietPeriod: defaultResolvedQuietPeriod,
+			MaxEntries:  defaultResolvedMaxEntries,
+		},
+		Digest: DigestConfig{
+			Interval: defaultDigestInterval,
+		},
+		DropDigest: DropDigestConfig{
+			Interval: defaultDropDigestInterval,
+		},
+		CrossNamespaceAggregation: CrossNamespaceAggregationConfig{
+			Window:    defaultAggregationWindow,
+			MaxGroups: defaultAggregationMaxGroups,
+		},
+		Routing: RoutingConfig{
+			Routes: map[string]string{
+				"Warning": "critical",
+				"Normal":  "bulk",
+			},
+		},
+		IncidentGrouping: IncidentGroupingConfig{
+			Window:     defaultIncidentWindow,
+			MaxObjects: defaultIncidentMaxObjects,
+		},
 	}
 }
 
@@ -6,6 +6,7 @@ package k8seventsreceiver
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,115 @@ func TestDefaultConfig(t *testing.T) {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		CollectorNamespace: CollectorNamespaceConfig{
+			EnvVar: "POD_NAMESPACE",
+		},
+		WatchAPI:             "v1",
+		ConsistentSampleRate: 1,
+		TimestampPrecision:   "ns",
+		NormalizeCase:        "none",
+		BodyTemplate:         "{message}",
+		SeverityText: SeverityTextConfig{
+			Types: map[string]string{
+				"Normal":  "INFO",
+				"Warning": "WARNING",
+			},
+			Reasons: map[string]string{
+				"Failed":           "ERROR",
+				"BackOff":          "ERROR",
+				"FailedScheduling": "ERROR",
+				"FailedMount":      "ERROR",
+				"Evicted":          "ERROR",
+				"OOMKilling":       "CRITICAL",
+				"NodeNotReady":     "CRITICAL",
+			},
+		},
+		Priority: PriorityConfig{
+			Weights: PriorityWeightsConfig{
+				Type:    40,
+				Reason:  30,
+				Count:   15,
+				Recency: 15,
+			},
+			CriticalReasons: []string{
+				"Failed", "BackOff", "FailedScheduling", "FailedMount", "Evicted", "OOMKilling", "NodeNotReady", "Unhealthy",
+			},
+			SaturationCount: 100,
+			RecencyWindow:   time.Hour,
+		},
+		Actionable: ActionableConfig{
+			Types: []string{"Warning"},
+			Reasons: []string{
+				"FailedScheduling", "FailedMount", "FailedCreate", "Evicted", "OOMKilling", "NodeNotReady",
+			},
+			InformationalReasons: []string{"DNSConfigForming", "ProbeWarning"},
+		},
+		TimestampDiscrepancy: TimestampDiscrepancyConfig{
+			Threshold: time.Minute,
+		},
+		FailedScheduling: FailedSchedulingConfig{
+			MaxReasons: 3,
+		},
+		Enrichment: EnrichmentConfig{
+			Kinds:    []string{"Pod"},
+			Timeout:  500 * time.Millisecond,
+			Fallback: "emit_without",
+			OwnerChainCache: OwnerChainCacheConfig{
+				TTL:            time.Minute,
+				MaxEntries:     10000,
+				MaxConcurrency: 8,
+			},
+		},
+		InvolvedObjectAnnotationSelector: AnnotationSelectorConfig{
+			NotCached: "drop",
+		},
+		Maintenance: MaintenanceConfig{
+			Action: "drop",
+		},
+		MinCountGrowthRate: CountGrowthRateConfig{
+			MaxEntries: 10000,
+		},
+		FirstOccurrenceOnly: FirstOccurrenceConfig{
+			MaxEntries: 10000,
+		},
+		DeadLetterLog: DeadLetterLogConfig{
+			MaxPerMinute: 10,
+		},
+		LoadSheddingOnFull: LoadSheddingConfig{
+			Cooldown: 30 * time.Second,
+		},
+		AdaptiveThrottle: AdaptiveThrottleConfig{
+			TargetLatency: 500 * time.Millisecond,
+			MinRate:       10,
+			MaxRate:       1000,
+		},
+		ReasonStreak: ReasonStreakConfig{
+			MaxObjects: 10000,
+		},
+		ResolvedEvents: ResolvedEventsConfig{
+			QuietPeriod: 10 * time.Minute,
+			MaxEntries:  10000,
+		},
+		Digest: DigestConfig{
+			Interval: time.Minute,
+		},
+		DropDigest: DropDigestConfig{
+			Interval: time.Minute,
+		},
+		CrossNamespaceAggregation: CrossNamespaceAggregationConfig{
+			Window:    time.Minute,
+			MaxGroups: 1000,
+		},
+		Routing: RoutingConfig{
+			Routes: map[string]string{
+				"Warning": "critical",
+				"Normal":  "bulk",
+			},
+		},
+		IncidentGrouping: IncidentGroupingConfig{
+			Window:     5 * time.Minute,
+			MaxObjects: 10000,
+		},
 	}, rCfg)
 }
 
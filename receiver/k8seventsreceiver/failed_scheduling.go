@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	// reasonFailedScheduling is the reason of the events reported when a pod can't be scheduled.
+	reasonFailedScheduling = "FailedScheduling"
+
+	// attributeSchedulingNodesEvaluated is the number of nodes evaluated by the scheduler.
+	attributeSchedulingNodesEvaluated = "k8s.scheduling.nodes_evaluated"
+
+	// attributeSchedulingReasons are the top reasons the nodes were rejected for.
+	attributeSchedulingReasons = "k8s.scheduling.reasons"
+)
+
+// failedSchedulingRegexp matches the messages of the scheduler, e.g.
+// `0/5 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: },
+// 4 Insufficient cpu. preemption: 0/5 nodes are available: 5 No preemption victims found for incoming pod.`
+var failedSchedulingRegexp = regexp.MustCompile(`^(\d+)/(\d+) nodes are available(?:: (.*?))?\.(?: preemption:.*)?$`)
+
+// schedulingReason is a reason nodes were rejected for, along with the count of the nodes.
+type schedulingReason struct {
+	reason string
+	nodes  int
+}
+
+// parseFailedScheduling extracts the number of the evaluated nodes and
+// the reasons the nodes were rejected for from the message of a FailedScheduling event.
+// The reasons are ordered by decreasing count of the rejected nodes.
+func parseFailedScheduling(msg string) (int, []schedulingReason, bool) {
+	m := failedSchedulingRegexp.FindStringSubmatch(strings.TrimSpace(msg))
+	if m == nil {
+		return 0, nil, false
+	}
+	nodes, err := strconv.Atoi(m[2])
+	if err != nil {
+		return 0, nil, false
+	}
+	var reasons []schedulingReason
+	if m[3] != "" {
+		for _, entry := range strings.Split(m[3], ", ") {
+			count, reason, ok := strings.Cut(entry, " ")
+			if !ok {
+				continue
+			}
+			n, err := strconv.Atoi(count)
+			if err != nil {
+				continue
+			}
+			reasons = append(reasons, schedulingReason{reason: reason, nodes: n})
+		}
+	}
+	slices.SortStableFunc(reasons, func(a, b schedulingReason) int {
+		return cmp.Compare(b.nodes, a.nodes)
+	})
+	return nodes, reasons, true
+}
+
+// putAttributes adds the scheduling context parsed from the message
+// of the event to attrs. Nothing is added when the message can't be parsed.
+func (cfg *FailedSchedulingConfig) putAttributes(attrs pcommon.Map, msg string) {
+	nodes, reasons, ok := parseFailedScheduling(msg)
+	if !ok {
+		return
+	}
+	attrs.PutInt(attributeSchedulingNodesEvaluated, int64(nodes))
+	if len(reasons) == 0 {
+		return
+	}
+	if cfg.MaxReasons > 0 && len(reasons) > cfg.MaxReasons {
+		reasons = reasons[:cfg.MaxReasons]
+	}
+	s := attrs.PutEmptySlice(attributeSchedulingReasons)
+	s.EnsureCapacity(len(reasons))
+	for _, r := range reasons {
+		s.AppendEmpty().SetStr(r.reason)
+	}
+}
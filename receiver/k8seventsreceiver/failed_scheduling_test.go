@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseFailedScheduling(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     string
+		nodes   int
+		reasons []schedulingReason
+		ok      bool
+	}{
+		{
+			name:  "with_preemption",
+			msg:   "0/5 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }, 4 Insufficient cpu. preemption: 0/5 nodes are available: 1 Preemption is not helpful for scheduling, 4 No preemption victims found for incoming pod.",
+			nodes: 5,
+			reasons: []schedulingReason{
+				{reason: "Insufficient cpu", nodes: 4},
+				{reason: "node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }", nodes: 1},
+			},
+			ok: true,
+		},
+		{
+			name:  "without_preemption",
+			msg:   "0/3 nodes are available: 2 node(s) didn't match Pod's node affinity/selector, 1 node(s) had volume node affinity conflict.",
+			nodes: 3,
+			reasons: []schedulingReason{
+				{reason: "node(s) didn't match Pod's node affinity/selector", nodes: 2},
+				{reason: "node(s) had volume node affinity conflict", nodes: 1},
+			},
+			ok: true,
+		},
+		{
+			name: "no_nodes",
+			msg:  "no nodes available to schedule pods",
+			ok:   false,
+		},
+		{
+			name:  "without_reasons",
+			msg:   "0/0 nodes are available.",
+			nodes: 0,
+			ok:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, reasons, ok := parseFailedScheduling(tt.msg)
+			require.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.nodes, nodes)
+			assert.Equal(t, tt.reasons, reasons)
+		})
+	}
+}
+
+func TestK8sEventToLogDataWithFailedScheduling(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FailedScheduling.Enabled = true
+	cfg.FailedScheduling.MaxReasons = 1
+
+	k8sEvent := getEvent()
+	k8sEvent.Reason = "FailedScheduling"
+	k8sEvent.Message = "0/5 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }, 4 Insufficient cpu. preemption: 0/5 nodes are available: 5 No preemption victims found for incoming pod."
+	ld := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil)
+	attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	nodes, ok := attrs.Get(attributeSchedulingNodesEvaluated)
+	require.True(t, ok)
+	assert.Equal(t, int64(5), nodes.Int())
+	reasons, ok := attrs.Get(attributeSchedulingReasons)
+	require.True(t, ok)
+	assert.Equal(t, []any{"Insufficient cpu"}, reasons.Slice().AsRaw())
+
+	// Only the FailedScheduling events are parsed.
+	k8sEvent.Reason = "Scheduled"
+	ld = k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil)
+	attrs = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	_, ok = attrs.Get(attributeSchedulingNodesEvaluated)
+	assert.False(t, ok)
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"container/list"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// occurrence identifies the occurrences of a reason for an object.
+type occurrence struct {
+	uid    types.UID
+	reason string
+}
+
+// occurrenceSet remembers the occurrences seen, up to maxEntries,
+// forgetting the oldest ones first when the set is full.
+type occurrenceSet struct {
+	maxEntries int
+
+	mu    sync.Mutex
+	seen  map[occurrence]*list.Element
+	order *list.List
+}
+
+func newOccurrenceSet(maxEntries int) *occurrenceSet {
+	return &occurrenceSet{
+		maxEntries: maxEntries,
+		seen:       make(map[occurrence]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// add records o, returning false if it was already seen.
+func (s *occurrenceSet) add(o occurrence) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.seen[o]; ok {
+		return false
+	}
+	if s.order.Len() >= s.maxEntries {
+		oldest := s.order.Front()
+		s.order.Remove(oldest)
+		delete(s.seen, oldest.Value.(occurrence))
+	}
+	s.seen[o] = s.order.PushBack(o)
+	return true
+}
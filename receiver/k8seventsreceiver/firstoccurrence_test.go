@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOccurrenceSet(t *testing.T) {
+	s := newOccurrenceSet(2)
+
+	assert.True(t, s.add(occurrence{uid: "pod-a", reason: "BackOff"}))
+	assert.False(t, s.add(occurrence{uid: "pod-a", reason: "BackOff"}))
+	assert.True(t, s.add(occurrence{uid: "pod-a", reason: "Unhealthy"}))
+
+	// The oldest occurrence is forgotten once the set is full.
+	assert.True(t, s.add(occurrence{uid: "pod-b", reason: "BackOff"}))
+	assert.True(t, s.add(occurrence{uid: "pod-a", reason: "BackOff"}))
+	assert.False(t, s.add(occurrence{uid: "pod-b", reason: "BackOff"}))
+}
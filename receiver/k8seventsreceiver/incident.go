@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// incidentTracker groups the events about the same object into incidents. An event belongs
+// to the ongoing incident of its object when it occurs within the window after the previous
+// event of the object, otherwise it opens a new incident. The incidents of at most maxObjects
+// objects are tracked, the expired ones being evicted first and then the least recent ones.
+type incidentTracker struct {
+	window     time.Duration
+	maxObjects int
+
+	mu        sync.Mutex
+	incidents map[types.UID]*incident
+}
+
+// incident is the ongoing incident of an object.
+type incident struct {
+	id   string
+	last time.Time
+}
+
+func newIncidentTracker(window time.Duration, maxObjects int) *incidentTracker {
+	return &incidentTracker{
+		window:     window,
+		maxObjects: maxObjects,
+		incidents:  make(map[types.UID]*incident),
+	}
+}
+
+// assign returns the ID of the incident the event about the object uid occurring at t belongs to.
+func (t *incidentTracker) assign(uid types.UID, ts time.Time) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if inc, ok := t.incidents[uid]; ok {
+		if ts.Sub(inc.last) <= t.window {
+			if ts.After(inc.last) {
+				inc.last = ts
+			}
+			return inc.id
+		}
+	} else if len(t.incidents) >= t.maxObjects {
+		t.evict(ts)
+	}
+	inc := &incident{id: incidentID(uid, ts), last: ts}
+	t.incidents[uid] = inc
+	return inc.id
+}
+
+// evict removes the incidents expired at ts, or the least recent one if none is expired.
+func (t *incidentTracker) evict(ts time.Time) {
+	var oldest types.UID
+	var oldestLast time.Time
+	evicted := false
+	for uid, inc := range t.incidents {
+		if ts.Sub(inc.last) > t.window {
+			delete(t.incidents, uid)
+			evicted = true
+			continue
+		}
+		if oldest == "" || inc.last.Before(oldestLast) {
+			oldest, oldestLast = uid, inc.last
+		}
+	}
+	if !evicted {
+		delete(t.incidents, oldest)
+	}
+}
+
+// incidentID derives the ID of the incident of the object uid
+// from the timestamp of the event opening the incident.
+func incidentID(uid types.UID, start time.Time) string {
+	h := fnv.New64a()
+	h.Write([]byte(uid))
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(start.UnixNano())))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
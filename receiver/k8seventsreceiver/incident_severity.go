@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+// attributeIncidentSeverity is the incident severity tier of the reason of the event.
+const attributeIncidentSeverity = "incident.severity"
+
+// Incident severity tiers, from the most severe.
+const (
+	incidentSeverity1 = "SEV1"
+	incidentSeverity2 = "SEV2"
+	incidentSeverity3 = "SEV3"
+	incidentSeverity4 = "SEV4"
+)
+
+// defaultIncidentSeverities maps the well-known Warning reasons to incident severity tiers:
+//   - SEV1: the nodes, and all their pods, are impaired,
+//   - SEV2: the pods can't start or are killed,
+//   - SEV3: the pods are degraded, e.g. crash looping or failing their probes,
+//   - SEV4: the misconfigurations without immediate impact.
+var defaultIncidentSeverities = map[string]string{
+	"NodeNotReady":    incidentSeverity1,
+	"SystemOOM":       incidentSeverity1,
+	"OOMKilling":      incidentSeverity1,
+	"NetworkNotReady": incidentSeverity1,
+
+	"FailedScheduling":       incidentSeverity2,
+	"FailedMount":            incidentSeverity2,
+	"FailedAttachVolume":     incidentSeverity2,
+	"FailedCreatePodSandBox": incidentSeverity2,
+	"FailedCreate":           incidentSeverity2,
+	"Evicted":                incidentSeverity2,
+
+	"BackOff":       incidentSeverity3,
+	"Failed":        incidentSeverity3,
+	"Unhealthy":     incidentSeverity3,
+	"FailedSync":    incidentSeverity3,
+	"FailedKillPod": incidentSeverity3,
+
+	"ProbeWarning":                 incidentSeverity4,
+	"DNSConfigForming":             incidentSeverity4,
+	"FailedGetResourceMetric":      incidentSeverity4,
+	"FailedComputeMetricsReplicas": incidentSeverity4,
+}
+
+// incidentSeverities are the supported incident severity tiers.
+var incidentSeverities = map[string]bool{
+	incidentSeverity1: true,
+	incidentSeverity2: true,
+	incidentSeverity3: true,
+	incidentSeverity4: true,
+}
+
+// severity returns the incident severity tier of the reason, from the configured reasons
+// overriding the default ones. The reasons configured with an empty tier aren't mapped.
+func (cfg *IncidentSeverityConfig) severity(reason string) (string, bool) {
+	if severity, ok := cfg.Reasons[reason]; ok {
+		return severity, severity != ""
+	}
+	severity, ok := defaultIncidentSeverities[reason]
+	return severity, ok
+}
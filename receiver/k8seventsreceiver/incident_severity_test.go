@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestK8sEventToLogDataWithIncidentSeverity(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.IncidentSeverity = IncidentSeverityConfig{
+		Enabled: true,
+		Reasons: map[string]string{
+			"BackOff":      incidentSeverity2,
+			"ProbeWarning": "",
+			"CustomAlert":  incidentSeverity1,
+		},
+	}
+
+	tests := []struct {
+		reason   string
+		expected string
+	}{
+		{reason: "OOMKilling", expected: "SEV1"},
+		{reason: "NodeNotReady", expected: "SEV1"},
+		{reason: "FailedScheduling", expected: "SEV2"},
+		{reason: "FailedMount", expected: "SEV2"},
+		{reason: "Unhealthy", expected: "SEV3"},
+		{reason: "DNSConfigForming", expected: "SEV4"},
+		// Overridden, unmapped and added by the configured reasons.
+		{reason: "BackOff", expected: "SEV2"},
+		{reason: "ProbeWarning"},
+		{reason: "CustomAlert", expected: "SEV1"},
+		{reason: "Pulled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.Type = "Warning"
+			lr := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			severity, ok := lr.Attributes().Get(attributeIncidentSeverity)
+			if tt.expected == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, severity.Str())
+		})
+	}
+}
+
+func TestK8sEventToLogDataWithoutIncidentSeverity(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.Reason = "OOMKilling"
+	lr := k8sEventToLogData(zap.NewNop(), k8sEvent, createDefaultConfig().(*Config), nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, ok := lr.Attributes().Get(attributeIncidentSeverity)
+	assert.False(t, ok)
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIncidentTracker(t *testing.T) {
+	start := time.Date(2025, time.January, 4, 22, 0, 0, 0, time.UTC)
+	tracker := newIncidentTracker(time.Minute, 10)
+
+	// A crash, a restart and a crash again form a single incident.
+	crash := tracker.assign("pod-a", start)
+	assert.Equal(t, crash, tracker.assign("pod-a", start.Add(40*time.Second)))
+	assert.Equal(t, crash, tracker.assign("pod-a", start.Add(90*time.Second)))
+	// Late events don't extend the incident.
+	assert.Equal(t, crash, tracker.assign("pod-a", start.Add(10*time.Second)))
+
+	// Other objects have their own incidents.
+	assert.NotEqual(t, crash, tracker.assign("pod-b", start))
+
+	// A new incident opens after a quiet period.
+	next := tracker.assign("pod-a", start.Add(3*time.Minute))
+	assert.NotEqual(t, crash, next)
+	assert.Equal(t, next, tracker.assign("pod-a", start.Add(3*time.Minute+time.Second)))
+}
+
+func TestIncidentTrackerEviction(t *testing.T) {
+	start := time.Date(2025, time.January, 4, 22, 0, 0, 0, time.UTC)
+	tracker := newIncidentTracker(time.Minute, 2)
+
+	// The expired incidents are evicted first.
+	tracker.assign("pod-a", start)
+	b := tracker.assign("pod-b", start.Add(30*time.Second))
+	tracker.assign("pod-c", start.Add(80*time.Second))
+	assert.Len(t, tracker.incidents, 2)
+	assert.NotContains(t, tracker.incidents, types.UID("pod-a"))
+	assert.Equal(t, b, tracker.assign("pod-b", start.Add(85*time.Second)))
+
+	// Then the least recent ones.
+	tracker.assign("pod-d", start.Add(90*time.Second))
+	assert.Len(t, tracker.incidents, 2)
+	assert.NotContains(t, tracker.incidents, types.UID("pod-c"))
+	assert.Equal(t, b, tracker.assign("pod-b", start.Add(95*time.Second)))
+}
+
+func TestHandleEventWithIncidentGrouping(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.IncidentGrouping.Enabled = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	crash := getEvent()
+	restart := getEvent()
+	restart.FirstTimestamp = v1.NewTime(crash.FirstTimestamp.Add(time.Minute))
+	other := getEvent()
+	other.InvolvedObject.UID = types.UID("7c3b-91ea")
+	noObject := getEvent()
+	noObject.InvolvedObject.UID = ""
+	for _, ev := range []*corev1.Event{crash, restart, other, noObject} {
+		recv.handleEvent(ev)
+	}
+
+	require.Equal(t, 4, sink.LogRecordCount())
+	ids := make([]string, 0, 3)
+	for i, ld := range sink.AllLogs() {
+		attr, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeIncidentID)
+		if i == 3 {
+			assert.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		ids = append(ids, attr.Str())
+	}
+	assert.Equal(t, ids[0], ids[1])
+	assert.NotEqual(t, ids[0], ids[2])
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"encoding/json"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// attributeEventAnnotationPrefix prefixes the parsed annotations of the events.
+const attributeEventAnnotationPrefix = "k8s.event.annotation."
+
+// putJSONAnnotations puts the annotations of keys found in annotations to m, prefixed with prefix.
+// The JSON objects are put as maps and the JSON arrays as slices, and the values that aren't
+// valid JSON are put as strings.
+func putJSONAnnotations(m pcommon.Map, prefix string, keys []string, annotations map[string]string) {
+	for _, key := range keys {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		putJSONValue(m, prefix+key, value)
+	}
+}
+
+func putJSONValue(m pcommon.Map, key, value string) {
+	var raw any
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		m.PutStr(key, value)
+		return
+	}
+	if err := m.PutEmpty(key).FromRaw(raw); err != nil {
+		m.PutStr(key, value)
+	}
+}
+
+// addInvolvedObjectJSONAnnotations adds the configured JSON annotations of the cached involved
+// object of the event to all the resources of ld, e.g. `k8s.pod.annotation.example.com/config`.
+func (kr *k8seventsReceiver) addInvolvedObjectJSONAnnotations(ld plog.Logs, ev *corev1.Event) {
+	if len(kr.config.ParseJSONAnnotations) == 0 {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	prefix := "k8s." + strings.ToLower(ev.InvolvedObject.Kind) + ".annotation."
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		putJSONAnnotations(rls.At(i).Resource().Attributes(), prefix, kr.config.ParseJSONAnnotations, accessor.GetAnnotations())
+	}
+}
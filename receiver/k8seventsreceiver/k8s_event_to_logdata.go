@@ -4,13 +4,19 @@
 package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
 
 import (
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 const (
@@ -19,6 +25,154 @@ const (
 
 	// Number of resource attributes to add to the plog.ResourceLogs.
 	totalResourceAttributes = 6
+
+	// attributeCollectorNamespace is the namespace the collector is running in.
+	attributeCollectorNamespace = "k8s.collector.namespace"
+
+	// attributeCollectorVersion is the version of the collector build emitting the event.
+	attributeCollectorVersion = "k8s.collector.version"
+
+	// attributeCollectorInstanceID identifies the collector process emitting the event.
+	attributeCollectorInstanceID = "k8s.collector.instance.id"
+
+	// attributeConfigHash is the hash of the configuration of the receiver emitting the event.
+	attributeConfigHash = "k8s.events.config_hash"
+
+	// attributeAPIServerEndpoint is the host of the API server the events are watched from.
+	attributeAPIServerEndpoint = "k8s.apiserver.endpoint"
+
+	// attributeInvolvedObject holds the structured reference to the object causing the event.
+	attributeInvolvedObject = "k8s.event.object"
+
+	// attributeRatePerMinute is the recurrence rate of an aggregated event.
+	attributeRatePerMinute = "k8s.event.rate_per_minute"
+
+	// attributeAge is the humanized time elapsed since the first occurrence of the event.
+	attributeAge = "k8s.event.age"
+
+	// attributeFirstSeen is the time of the first occurrence of the event.
+	attributeFirstSeen = "k8s.event.first_seen"
+
+	// attributeLastSeen is the time of the last occurrence of the event.
+	attributeLastSeen = "k8s.event.last_seen"
+
+	// attributeTimestampRFC3339 is the timestamp of the log record in RFC 3339 format in UTC.
+	attributeTimestampRFC3339 = "k8s.event.timestamp.rfc3339"
+
+	// attributeTimestampDiscrepancy is the difference between the event time and the last timestamp of the event.
+	attributeTimestampDiscrepancy = "k8s.event.timestamp.discrepancy_seconds"
+
+	// attributeSeriesCount is the number of occurrences of an event of the `events.k8s.io` series model.
+	attributeSeriesCount = "k8s.event.series.count"
+
+	// attributeSeriesContinuation flags the events continuing a series.
+	attributeSeriesContinuation = "k8s.event.series.continuation"
+
+	// attributeInternalLatency is the time elapsed in the receiver between the delivery and the emission of the event.
+	attributeInternalLatency = "k8s.event.internal_latency_ms"
+
+	// attributeMessage is the message of the event, i.e. the note of the `events.k8s.io/v1` API.
+	attributeMessage = "k8s.event.message"
+
+	// attributeContentHash is the hash of the content of the event.
+	attributeContentHash = "k8s.event.content_hash"
+
+	// attributeEventPrefix prefixes the attributes of the events nested in the event domain mode.
+	attributeEventPrefix = "k8s.event."
+
+	// attributeEventDomain is the domain of the OTel events, set to eventDomainK8s in the event domain mode.
+	attributeEventDomain = "event.domain"
+	eventDomainK8s       = "k8s"
+
+	// attributeSummary is the compact single-line summary of the event.
+	attributeSummary = "k8s.event.summary"
+
+	// attributeMaintenance flags events occurring during a maintenance window.
+	attributeMaintenance = "k8s.event.maintenance"
+
+	// attributeContainerTerminationReason is the reason of the last termination of the crashing container.
+	attributeContainerTerminationReason = "k8s.container.last_termination.reason"
+
+	// attributeContainerTerminationExitCode is the exit code of the last termination of the crashing container.
+	attributeContainerTerminationExitCode = "k8s.container.last_termination.exit_code"
+
+	// attributeProbeType is the type of the probe reported by a probe event, e.g. `readiness`.
+	attributeProbeType = "k8s.probe.type"
+
+	// attributePodReady is the status of the Ready condition of the pod of a probe event.
+	attributePodReady = "k8s.pod.ready"
+
+	// attributeAttributesTrimmed flags log records whose attributes were trimmed to the limit.
+	attributeAttributesTrimmed = "k8s.event.attributes.trimmed"
+
+	// attributeMatchedFilters lists the filters an event passed.
+	attributeMatchedFilters = "k8s.event.matched_filters"
+
+	// attributeNamespaceOwner is the owner of the event's namespace, resolved from its annotations.
+	attributeNamespaceOwner = "k8s.namespace.owner"
+
+	// attributeWorkloadGeneration is the generation of the workload involved in the event.
+	attributeWorkloadGeneration = "k8s.workload.generation"
+
+	// attributeWorkloadObservedGeneration is the generation of the workload observed by its controller.
+	attributeWorkloadObservedGeneration = "k8s.workload.observed_generation"
+
+	// attributeServicePorts are the ports of the Service involved in the event.
+	attributeServicePorts = "k8s.service.ports"
+
+	// attributeEndpointsAddresses are the ready addresses of the Endpoints involved in the event.
+	attributeEndpointsAddresses = "k8s.endpoints.addresses"
+
+	// attributeEndpointsPorts are the ports of the Endpoints involved in the event.
+	attributeEndpointsPorts = "k8s.endpoints.ports"
+
+	// attributePVCName is the name of the PersistentVolumeClaim bound to the volume involved in the event.
+	attributePVCName = "k8s.pvc.name"
+
+	// attributePVCStorageClass is the storage class of the PersistentVolumeClaim involved in the event.
+	attributePVCStorageClass = "k8s.pvc.storage_class"
+
+	// attributePVCPhase is the phase of the PersistentVolumeClaim involved in the event.
+	attributePVCPhase = "k8s.pvc.phase"
+
+	// attributePVName is the name of the PersistentVolume bound to the claim involved in the event.
+	attributePVName = "k8s.pv.name"
+
+	// attributePVStorageClass is the storage class of the PersistentVolume involved in the event.
+	attributePVStorageClass = "k8s.pv.storage_class"
+
+	// attributePVPhase is the phase of the PersistentVolume involved in or bound to the claim of the event.
+	attributePVPhase = "k8s.pv.phase"
+
+	// attributePVCapacity is the capacity of the PersistentVolume involved in or bound to the claim of the event.
+	attributePVCapacity = "k8s.pv.capacity"
+
+	// attributeResourceQuotaName is the name of the ResourceQuota involved in or exceeded by the event.
+	attributeResourceQuotaName = "k8s.resource_quota.name"
+
+	// attributeResourceQuotaUsed is the usage of the resources limited by the ResourceQuota.
+	attributeResourceQuotaUsed = "k8s.resource_quota.used"
+
+	// attributeResourceQuotaHard are the limits of the resources set by the ResourceQuota.
+	attributeResourceQuotaHard = "k8s.resource_quota.hard"
+
+	// attributeObjectAgeAtEvent is the age of the involved object at the time of the event, in seconds.
+	attributeObjectAgeAtEvent = "k8s.event.object_age_at_event_seconds"
+
+	// attributeWatchScope tells whether the event was delivered by a watch of the whole cluster or of a namespace.
+	attributeWatchScope = "k8s.event.watch.scope"
+
+	// attributeControllerRevision is the revision of the rollout the event belongs to.
+	attributeControllerRevision = "k8s.controller.revision"
+
+	// attributeJobSucceeded is the number of the pods of the Job which succeeded.
+	attributeJobSucceeded = "k8s.job.succeeded"
+
+	// attributeJobFailed is the number of the pods of the Job which failed.
+	attributeJobFailed = "k8s.job.failed"
+
+	// attributeIncidentID identifies the incident grouping the events about the same object.
+	attributeIncidentID = "k8s.incident.id"
 )
 
 // Only two types of events are created as of now.
@@ -28,8 +182,36 @@ var severityMap = map[string]plog.SeverityNumber{
 	"warning": plog.SeverityNumberWarn,
 }
 
+// semanticNameAttributes maps the kinds of involved objects to the
+// resource attributes holding their names in the semantic conventions.
+var semanticNameAttributes = map[string]string{
+	"Pod":         semconv.AttributeK8SPodName,
+	"Node":        semconv.AttributeK8SNodeName,
+	"Namespace":   semconv.AttributeK8SNamespaceName,
+	"Deployment":  semconv.AttributeK8SDeploymentName,
+	"ReplicaSet":  semconv.AttributeK8SReplicaSetName,
+	"StatefulSet": semconv.AttributeK8SStatefulSetName,
+	"DaemonSet":   semconv.AttributeK8SDaemonSetName,
+	"Job":         semconv.AttributeK8SJobName,
+	"CronJob":     semconv.AttributeK8SCronJobName,
+}
+
+// severityTextNumbers are the severity texts supported in the severity_text
+// mappings, along with the severity numbers set consistently with them.
+var severityTextNumbers = map[string]plog.SeverityNumber{
+	"TRACE":    plog.SeverityNumberTrace,
+	"DEBUG":    plog.SeverityNumberDebug,
+	"INFO":     plog.SeverityNumberInfo,
+	"NOTICE":   plog.SeverityNumberInfo2,
+	"WARNING":  plog.SeverityNumberWarn,
+	"ERROR":    plog.SeverityNumberError,
+	"CRITICAL": plog.SeverityNumberFatal,
+	"FATAL":    plog.SeverityNumberFatal4,
+}
+
 // k8sEventToLogRecord converts Kubernetes event to plog.LogRecordSlice and adds the resource attributes.
-func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
+// The message is redacted with the compiled MessageRedactionPatterns of cfg.
+func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event, cfg *Config, redactions []*regexp.Regexp) plog.Logs {
 	ld := plog.NewLogs()
 	rl := ld.ResourceLogs().AppendEmpty()
 	sl := rl.ScopeLogs().AppendEmpty()
@@ -40,38 +222,87 @@ func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
 
 	resourceAttrs.PutStr(semconv.AttributeK8SNodeName, ev.Source.Host)
 
+	if cfg.ReportingControllerAsService {
+		if controller := reportingController(ev); controller != "" {
+			resourceAttrs.PutStr(semconv.AttributeServiceName, controller)
+		}
+	}
+	if service := cfg.NamespaceService.service(ev.Namespace); service != "" {
+		resourceAttrs.PutStr(semconv.AttributeServiceName, service)
+	}
+
 	// Attributes related to the object causing the event.
-	resourceAttrs.PutStr("k8s.object.kind", ev.InvolvedObject.Kind)
-	resourceAttrs.PutStr("k8s.object.name", ev.InvolvedObject.Name)
-	resourceAttrs.PutStr("k8s.object.uid", string(ev.InvolvedObject.UID))
-	resourceAttrs.PutStr("k8s.object.fieldpath", ev.InvolvedObject.FieldPath)
-	resourceAttrs.PutStr("k8s.object.api_version", ev.InvolvedObject.APIVersion)
-	resourceAttrs.PutStr("k8s.object.resource_version", ev.InvolvedObject.ResourceVersion)
+	// Synthetic or malformed events may have no involved object at all,
+	// in which case no empty attributes are emitted for it.
+	hasInvolvedObject := ev.InvolvedObject != (corev1.ObjectReference{})
+	involvedObject := ev.InvolvedObject
+	involvedObject.Kind = normalizeCase(cfg.NormalizeCase, involvedObject.Kind)
+	if hasInvolvedObject && !cfg.InvolvedObjectAsMap {
+		resourceAttrs.PutStr("k8s.object.kind", involvedObject.Kind)
+		resourceAttrs.PutStr("k8s.object.name", involvedObject.Name)
+		resourceAttrs.PutStr("k8s.object.uid", string(involvedObject.UID))
+		resourceAttrs.PutStr("k8s.object.fieldpath", involvedObject.FieldPath)
+		resourceAttrs.PutStr("k8s.object.api_version", involvedObject.APIVersion)
+		resourceAttrs.PutStr("k8s.object.resource_version", involvedObject.ResourceVersion)
+	}
 
-	lr.SetTimestamp(pcommon.NewTimestampFromTime(getEventTimestamp(ev)))
+	if hasInvolvedObject && cfg.SemanticObjectName {
+		if key, ok := semanticNameAttributes[ev.InvolvedObject.Kind]; ok {
+			resourceAttrs.PutStr(key, ev.InvolvedObject.Name)
+		}
+	}
+
+	timestamp := cfg.logRecordTimestamp(ev)
+	if precision, ok := timestampPrecisions[cfg.TimestampPrecision]; ok {
+		timestamp = timestamp.Truncate(precision)
+	}
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
 	// The Message field contains description about the event,
 	// which is best suited for the "Body" of the LogRecordSlice.
-	lr.Body().SetStr(ev.Message)
+	message := redactMessage(redactions, cfg.NormalizeMessage.apply(ev.Message))
+	lr.Body().SetStr(eventBody(cfg.bodyTemplate(ev), ev, message))
 
 	// Set the "SeverityNumber" and "SeverityText" if a known type of
 	// severity is found.
 	if severityNumber, ok := severityMap[strings.ToLower(ev.Type)]; ok {
 		lr.SetSeverityNumber(severityNumber)
-		lr.SetSeverityText(ev.Type)
+		lr.SetSeverityText(normalizeCase(cfg.NormalizeCase, ev.Type))
 	} else {
 		logger.Debug("unknown severity type", zap.String("type", ev.Type))
 	}
 
+	if cfg.SeverityText.Enabled {
+		if text, ok := cfg.SeverityText.lookup(ev); ok {
+			lr.SetSeverityText(text)
+			lr.SetSeverityNumber(severityTextNumbers[text])
+		}
+	}
+
 	attrs := lr.Attributes()
 	attrs.EnsureCapacity(totalLogAttributes)
 
-	attrs.PutStr("k8s.event.reason", ev.Reason)
+	reason := ev.Reason
+	if reason == "" {
+		reason = cfg.DefaultReason
+	}
+	attrs.PutStr("k8s.event.reason", normalizeCase(cfg.NormalizeCase, reason))
 	attrs.PutStr("k8s.event.action", ev.Action)
 	attrs.PutStr("k8s.event.start_time", ev.CreationTimestamp.String())
 	attrs.PutStr("k8s.event.name", ev.Name)
 	attrs.PutStr("k8s.event.uid", string(ev.UID))
-	attrs.PutStr(semconv.AttributeK8SNamespaceName, ev.InvolvedObject.Namespace)
+	if cfg.EmitMessageAttribute {
+		attrs.PutStr(attributeMessage, message)
+	}
+	if hasInvolvedObject {
+		attrs.PutStr(semconv.AttributeK8SNamespaceName, ev.InvolvedObject.Namespace)
+	} else if ev.Namespace != "" {
+		attrs.PutStr(semconv.AttributeK8SNamespaceName, ev.Namespace)
+	}
+
+	if hasInvolvedObject && cfg.InvolvedObjectAsMap {
+		putInvolvedObjectMap(attrs.PutEmptyMap(attributeInvolvedObject), &involvedObject)
+	}
 
 	// "Count" field of k8s event will be '0' in case it is
 	// not present in the collected event from k8s.
@@ -79,5 +310,503 @@ func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
 		attrs.PutInt("k8s.event.count", int64(ev.Count))
 	}
 
+	if cfg.EmitAge {
+		if age, ok := eventAge(ev, time.Now()); ok {
+			attrs.PutStr(attributeAge, age)
+		}
+	}
+
+	if cfg.EmitSeenTimestamps {
+		first, last := eventSeen(ev)
+		if !first.IsZero() {
+			attrs.PutStr(attributeFirstSeen, first.UTC().Format(time.RFC3339Nano))
+		}
+		if !last.IsZero() {
+			attrs.PutStr(attributeLastSeen, last.UTC().Format(time.RFC3339Nano))
+		}
+	}
+
+	if cfg.EmitRFC3339Timestamp && !timestamp.IsZero() {
+		attrs.PutStr(attributeTimestampRFC3339, timestamp.UTC().Format(time.RFC3339Nano))
+	}
+
+	if cfg.TimestampDiscrepancy.Enabled {
+		if discrepancy, ok := timestampDiscrepancy(ev, cfg.TimestampDiscrepancy.Threshold); ok {
+			attrs.PutDouble(attributeTimestampDiscrepancy, discrepancy.Seconds())
+			if cfg.TimestampDiscrepancy.LogWarning {
+				logger.Warn("the event time and the last timestamp of the event disagree",
+					zap.String("uid", string(ev.UID)),
+					zap.Time("event_time", ev.EventTime.Time),
+					zap.Time("last_timestamp", ev.LastTimestamp.Time),
+					zap.Duration("discrepancy", discrepancy))
+			}
+		}
+	}
+
+	if cfg.EmitSeriesPosition {
+		if count, continuation, ok := seriesPosition(ev); ok {
+			attrs.PutInt(attributeSeriesCount, int64(count))
+			attrs.PutBool(attributeSeriesContinuation, continuation)
+		}
+	}
+
+	if cfg.EmitContentHash {
+		attrs.PutStr(attributeContentHash, eventContentHash(ev))
+	}
+
+	if key, ok := partitionKey(cfg.PartitionKeySource, ev); ok {
+		attrs.PutStr(semconv.AttributeMessagingKafkaMessageKey, key)
+	}
+
+	if cfg.CompactSummary {
+		attrs.PutStr(attributeSummary, eventSummary(ev))
+	}
+
+	putJSONAnnotations(attrs, attributeEventAnnotationPrefix, cfg.ParseJSONAnnotations, ev.Annotations)
+
+	if cfg.ConsoleURLTemplate != "" {
+		if consoleURL, ok := eventConsoleURL(cfg.ConsoleURLTemplate, ev); ok {
+			attrs.PutStr(attributeConsoleURL, consoleURL)
+		}
+	}
+
+	if cfg.Priority.Enabled {
+		attrs.PutInt(attributePriority, cfg.Priority.eventPriority(ev, time.Now()))
+	}
+
+	if cfg.IncidentSeverity.Enabled {
+		if severity, ok := cfg.IncidentSeverity.severity(ev.Reason); ok {
+			attrs.PutStr(attributeIncidentSeverity, severity)
+		}
+	}
+
+	if cfg.Actionable.Enabled {
+		attrs.PutBool(attributeActionable, cfg.Actionable.actionable(ev))
+	}
+
+	if cfg.FailedScheduling.Enabled && ev.Reason == reasonFailedScheduling {
+		cfg.FailedScheduling.putAttributes(attrs, ev.Message)
+	}
+
+	if cfg.EmitRate {
+		if rate, ok := eventRatePerMinute(ev); ok {
+			attrs.PutDouble(attributeRatePerMinute, rate)
+		}
+	}
+
+	if cfg.EmitReportingSubsystem {
+		if subsystem, ok := reportingSubsystem(ev); ok {
+			attrs.PutStr(attributeReportingSubsystem, subsystem)
+		}
+	}
+
 	return ld
 }
+
+// nestLogRecordsEventDomain structures the attributes of the log records of ld as OTel events.
+func nestLogRecordsEventDomain(ld plog.Logs) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				nestEventDomain(lrs.At(k).Attributes())
+			}
+		}
+	}
+}
+
+// nestEventDomain structures attrs as the attributes of an OTel event of the `k8s` domain named
+// after the emitted reason, moving the `k8s.event.*` attributes into the `k8s.event` map.
+func nestEventDomain(attrs pcommon.Map) {
+	event := pcommon.NewMap()
+	attrs.RemoveIf(func(k string, v pcommon.Value) bool {
+		name, ok := strings.CutPrefix(k, attributeEventPrefix)
+		if ok {
+			v.CopyTo(event.PutEmpty(name))
+		}
+		return ok
+	})
+	if reason, ok := event.Get("reason"); ok {
+		attrs.PutStr(semconv.AttributeEventName, reason.Str())
+	}
+	attrs.PutStr(attributeEventDomain, eventDomainK8s)
+	event.MoveTo(attrs.PutEmptyMap(strings.TrimSuffix(attributeEventPrefix, ".")))
+}
+
+// putInvolvedObjectMap fills m with the reference to the object causing the event.
+func putInvolvedObjectMap(m pcommon.Map, ref *corev1.ObjectReference) {
+	m.EnsureCapacity(7)
+	m.PutStr("kind", ref.Kind)
+	m.PutStr("name", ref.Name)
+	m.PutStr("namespace", ref.Namespace)
+	m.PutStr("uid", string(ref.UID))
+	m.PutStr("api_version", ref.APIVersion)
+	m.PutStr("fieldpath", ref.FieldPath)
+	m.PutStr("resource_version", ref.ResourceVersion)
+}
+
+// eventRatePerMinute returns how many times per minute an aggregated event occurred
+// between its first and last occurrence. Spans shorter than a minute count as one
+// minute so that bursts don't produce inflated rates.
+func eventRatePerMinute(ev *corev1.Event) (float64, bool) {
+	if ev.Count <= 0 || ev.FirstTimestamp.IsZero() || ev.LastTimestamp.IsZero() {
+		return 0, false
+	}
+	minutes := max(1, ev.LastTimestamp.Sub(ev.FirstTimestamp.Time).Minutes())
+	return float64(ev.Count) / minutes, true
+}
+
+// eventAge returns the time elapsed between the first occurrence of the event and now,
+// humanized the same way as the age of the events in `kubectl get events`.
+func eventAge(ev *corev1.Event, now time.Time) (string, bool) {
+	first := ev.FirstTimestamp.Time
+	if first.IsZero() {
+		first = ev.EventTime.Time
+	}
+	if first.IsZero() {
+		return "", false
+	}
+	return duration.HumanDuration(now.Sub(first)), true
+}
+
+// eventSeen returns the times of the first and the last occurrences of the event, zero when unknown,
+// from the core fields, or the events.k8s.io fields for the events reported through that API.
+// An event reported once through the events.k8s.io API was last seen when it was first seen.
+func eventSeen(ev *corev1.Event) (first, last time.Time) {
+	first = ev.FirstTimestamp.Time
+	if first.IsZero() {
+		first = ev.EventTime.Time
+	}
+	last = ev.LastTimestamp.Time
+	if last.IsZero() && ev.Series != nil {
+		last = ev.Series.LastObservedTime.Time
+	}
+	if last.IsZero() {
+		last = ev.EventTime.Time
+	}
+	return first, last
+}
+
+// timestampDiscrepancy returns the absolute difference between the event time and the last timestamp of
+// the event, when both are set and differ by more than threshold.
+func timestampDiscrepancy(ev *corev1.Event, threshold time.Duration) (time.Duration, bool) {
+	if ev.EventTime.IsZero() || ev.LastTimestamp.IsZero() {
+		return 0, false
+	}
+	discrepancy := ev.LastTimestamp.Sub(ev.EventTime.Time).Abs()
+	return discrepancy, discrepancy > threshold
+}
+
+// eventContentHash returns the FNV-1a hash of the reason, the message and the type of the event,
+// which stays the same as long as the recurrences of an event have the same content.
+func eventContentHash(ev *corev1.Event) string {
+	h := fnv.New64a()
+	for _, field := range []string{ev.Reason, ev.Message, ev.Type} {
+		h.Write([]byte(field))
+		// Separate the fields so that moving text across them changes the hash.
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// partitionKey returns the key partitioning the event ev in the sharded downstreams from source, so
+// that the events about the same object or namespace land on the same partition. The namespace of the
+// cluster scoped objects is the one of the event. It returns false when the source field is unset.
+func partitionKey(source string, ev *corev1.Event) (string, bool) {
+	var key string
+	switch source {
+	case partitionKeySourceUID:
+		key = string(ev.InvolvedObject.UID)
+	case partitionKeySourceNamespace:
+		key = ev.InvolvedObject.Namespace
+		if key == "" {
+			key = ev.Namespace
+		}
+	}
+	return key, key != ""
+}
+
+// eventSummary returns the type, the reason, the involved object and the count of the event on a
+// single line, e.g. `Warning/BackOff default/my-pod x5`. The count is omitted for single occurrences.
+func eventSummary(ev *corev1.Event) string {
+	var sb strings.Builder
+	sb.WriteString(ev.Type)
+	sb.WriteByte('/')
+	sb.WriteString(ev.Reason)
+	if ev.InvolvedObject.Name != "" {
+		sb.WriteByte(' ')
+		if ev.InvolvedObject.Namespace != "" {
+			sb.WriteString(ev.InvolvedObject.Namespace)
+			sb.WriteByte('/')
+		}
+		sb.WriteString(ev.InvolvedObject.Name)
+	}
+	if count := eventCount(ev); count > 1 {
+		sb.WriteString(" x")
+		sb.WriteString(strconv.FormatInt(int64(count), 10))
+	}
+	return sb.String()
+}
+
+// reportingController returns the name of the controller which emitted the event.
+// The events.k8s.io field is preferred over the deprecated source component.
+func reportingController(ev *corev1.Event) string {
+	if ev.ReportingController != "" {
+		return ev.ReportingController
+	}
+	return ev.Source.Component
+}
+
+// prefixLogRecordsAttributes prepends prefix to all the keys of the attributes of the log records of ld.
+func prefixLogRecordsAttributes(ld plog.Logs, prefix string) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				prefixAttributes(lrs.At(k).Attributes(), prefix)
+			}
+		}
+	}
+}
+
+// prefixAttributes prepends prefix to all the keys of attrs.
+func prefixAttributes(attrs pcommon.Map, prefix string) {
+	orig := pcommon.NewMap()
+	attrs.MoveTo(orig)
+	attrs.EnsureCapacity(orig.Len())
+	orig.Range(func(k string, v pcommon.Value) bool {
+		v.CopyTo(attrs.PutEmpty(prefix + k))
+		return true
+	})
+}
+
+// lookup returns the severity text of the event, looking up its reason first,
+// then the keywords found in its reason or message, and then its type.
+func (cfg *SeverityTextConfig) lookup(ev *corev1.Event) (string, bool) {
+	if text, ok := cfg.Reasons[ev.Reason]; ok {
+		return text, true
+	}
+	if text, ok := cfg.lookupKeywords(ev); ok {
+		return text, true
+	}
+	for typ, text := range cfg.Types {
+		if strings.EqualFold(typ, ev.Type) {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+// lookupKeywords returns the highest severity text among the keywords found in the reason or the
+// message of the event. The ties between different texts of the same severity are broken by keyword.
+func (cfg *SeverityTextConfig) lookupKeywords(ev *corev1.Event) (string, bool) {
+	if len(cfg.Keywords) == 0 {
+		return "", false
+	}
+	reason, message := strings.ToLower(ev.Reason), strings.ToLower(ev.Message)
+	var found, foundText string
+	for keyword, text := range cfg.Keywords {
+		kw := strings.ToLower(keyword)
+		if !strings.Contains(reason, kw) && !strings.Contains(message, kw) {
+			continue
+		}
+		if found == "" || severityTextNumbers[text] > severityTextNumbers[foundText] ||
+			severityTextNumbers[text] == severityTextNumbers[foundText] && keyword < found {
+			found, foundText = keyword, text
+		}
+	}
+	return foundText, found != ""
+}
+
+// regroupLogRecordsAttributes regroups the attributes of the log records of ld with the attributes
+// of their resource, ld holding a single log record per resource.
+func regroupLogRecordsAttributes(ld plog.Logs, groupBy []string) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				regroupAttributes(rls.At(i).Resource().Attributes(), lrs.At(k).Attributes(), groupBy)
+			}
+		}
+	}
+}
+
+// regroupAttributes moves the attributes listed in groupBy to the resource
+// and all the other resource attributes to the log record.
+func regroupAttributes(resourceAttrs, attrs pcommon.Map, groupBy []string) {
+	inGroup := make(map[string]bool, len(groupBy))
+	for _, k := range groupBy {
+		inGroup[k] = true
+	}
+	resourceAttrs.RemoveIf(func(k string, v pcommon.Value) bool {
+		if inGroup[k] {
+			return false
+		}
+		v.CopyTo(attrs.PutEmpty(k))
+		return true
+	})
+	attrs.RemoveIf(func(k string, v pcommon.Value) bool {
+		if !inGroup[k] {
+			return false
+		}
+		v.CopyTo(resourceAttrs.PutEmpty(k))
+		return true
+	})
+}
+
+// trimAttributes trims the attributes of all the log records of ld to the configured limit,
+// flagging the trimmed records. The attributes to keep are chosen deterministically:
+// the prioritized ones first, then the others in the lexical order of their keys.
+func (cfg *AttributeLimitsConfig) trimAttributes(ld plog.Logs) {
+	if cfg.MaxAttributes <= 0 {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				cfg.trimMap(lrs.At(k).Attributes())
+			}
+		}
+	}
+}
+
+func (cfg *AttributeLimitsConfig) trimMap(attrs pcommon.Map) {
+	if attrs.Len() <= cfg.MaxAttributes {
+		return
+	}
+	// One attribute is left for the flag.
+	keep := make(map[string]bool, cfg.MaxAttributes-1)
+	for _, k := range cfg.Priority {
+		if len(keep) == cfg.MaxAttributes-1 {
+			break
+		}
+		if _, ok := attrs.Get(k); ok {
+			keep[k] = true
+		}
+	}
+	keys := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	slices.Sort(keys)
+	for _, k := range keys {
+		if len(keep) == cfg.MaxAttributes-1 {
+			break
+		}
+		keep[k] = true
+	}
+	attrs.RemoveIf(func(k string, _ pcommon.Value) bool {
+		return !keep[k]
+	})
+	attrs.PutBool(attributeAttributesTrimmed, true)
+}
+
+// sortAttributes sorts the resource and the log attributes of ld in the lexical order of their keys.
+// The attributes are inserted in the order of the features emitting them, some of which insert the
+// entries of Go maps, e.g. the labels or the resource quotas, in a random order.
+func sortAttributes(ld plog.Logs) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sortMap(rls.At(i).Resource().Attributes())
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				sortMap(lrs.At(k).Attributes())
+			}
+		}
+	}
+}
+
+// sortMap sorts m, and the maps nested in it, in the lexical order of their keys.
+func sortMap(m pcommon.Map) {
+	orig := pcommon.NewMap()
+	m.MoveTo(orig)
+	keys := make([]string, 0, orig.Len())
+	orig.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	slices.Sort(keys)
+	m.EnsureCapacity(len(keys))
+	for _, k := range keys {
+		v, _ := orig.Get(k)
+		dest := m.PutEmpty(k)
+		v.CopyTo(dest)
+		sortNested(dest)
+	}
+}
+
+// sortNested sorts the maps held by v, directly or in slices.
+func sortNested(v pcommon.Value) {
+	switch v.Type() {
+	case pcommon.ValueTypeMap:
+		sortMap(v.Map())
+	case pcommon.ValueTypeSlice:
+		s := v.Slice()
+		for i := 0; i < s.Len(); i++ {
+			sortNested(s.At(i))
+		}
+	}
+}
+
+// normalizeCase converts s to the case of mode, unchanged for "none".
+func normalizeCase(mode, s string) string {
+	switch mode {
+	case normalizeCaseLower:
+		return strings.ToLower(s)
+	case normalizeCaseUpper:
+		return strings.ToUpper(s)
+	default:
+		return s
+	}
+}
+
+// redactMessage replaces the content of the message msg matching any of the redactions with "***".
+// Only the content of the capturing groups is replaced for the redactions having any, so that
+// the context of the secrets can be kept, e.g. the key of "password=secret".
+func redactMessage(redactions []*regexp.Regexp, msg string) string {
+	for _, re := range redactions {
+		if re.NumSubexp() == 0 {
+			msg = re.ReplaceAllLiteralString(msg, "***")
+			continue
+		}
+		var b strings.Builder
+		last := 0
+		for _, match := range re.FindAllStringSubmatchIndex(msg, -1) {
+			for i := 2; i < len(match); i += 2 {
+				// Unmatched groups, and groups nested in a replaced group, are skipped.
+				if match[i] < last {
+					continue
+				}
+				b.WriteString(msg[last:match[i]])
+				b.WriteString("***")
+				last = match[i+1]
+			}
+		}
+		b.WriteString(msg[last:])
+		msg = b.String()
+	}
+	return msg
+}
+
+// apply normalizes the whitespace of the event message.
+func (cfg *NormalizeMessageConfig) apply(msg string) string {
+	if !cfg.Enabled {
+		return msg
+	}
+	if cfg.CollapseWhitespace {
+		return strings.Join(strings.Fields(msg), " ")
+	}
+	return strings.TrimSpace(msg)
+}
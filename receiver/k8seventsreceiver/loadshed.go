@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// capacityErrorMessages are the messages of the errors returned by the components of the pipeline
+// when they are at capacity, matched by text since their error values aren't importable by receivers.
+var capacityErrorMessages = []string{
+	// The sending queue of the exporters.
+	"sending queue is full",
+	// The memory_limiter processor.
+	"data refused due to high memory usage",
+}
+
+// isCapacityError returns whether err reports the pipeline being at capacity, i.e. whether
+// pushing more events into it would only make things worse.
+func isCapacityError(err error) bool {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.ResourceExhausted {
+		return true
+	}
+	msg := err.Error()
+	for _, m := range capacityErrorMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadShedder drops the events for a cooldown period once the pipeline reports being at capacity.
+type loadShedder struct {
+	cooldown time.Duration
+	// until is the end of the ongoing cooldown in nanoseconds since the epoch.
+	until atomic.Int64
+}
+
+func newLoadShedder(cooldown time.Duration) *loadShedder {
+	return &loadShedder{cooldown: cooldown}
+}
+
+// shedding returns whether the events are dropped at now.
+func (s *loadShedder) shedding(now time.Time) bool {
+	return now.UnixNano() < s.until.Load()
+}
+
+// observe starts a cooldown at now if err reports the pipeline being at capacity,
+// and returns whether it did.
+func (s *loadShedder) observe(err error, now time.Time) bool {
+	if err == nil || !isCapacityError(err) {
+		return false
+	}
+	s.until.Store(now.Add(s.cooldown).UnixNano())
+	return true
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsCapacityError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "sending_queue_full",
+			err:      errors.New("sending queue is full"),
+			expected: true,
+		},
+		{
+			name:     "memory_limiter",
+			err:      fmt.Errorf("failed to consume: %w", errors.New("data refused due to high memory usage")),
+			expected: true,
+		},
+		{
+			name:     "resource_exhausted",
+			err:      status.Error(codes.ResourceExhausted, "too many requests"),
+			expected: true,
+		},
+		{
+			name:     "wrapped_resource_exhausted",
+			err:      fmt.Errorf("export failed: %w", status.Error(codes.ResourceExhausted, "too many requests")),
+			expected: true,
+		},
+		{
+			name: "unavailable",
+			err:  status.Error(codes.Unavailable, "connection refused"),
+		},
+		{
+			name: "permanent",
+			err:  consumererror.NewPermanent(errors.New("invalid log record")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isCapacityError(tt.err))
+		})
+	}
+}
+
+func TestLoadShedder(t *testing.T) {
+	s := newLoadShedder(time.Minute)
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	assert.False(t, s.shedding(now))
+
+	assert.False(t, s.observe(nil, now))
+	assert.False(t, s.observe(errors.New("invalid log record"), now))
+	assert.False(t, s.shedding(now))
+
+	assert.True(t, s.observe(errors.New("sending queue is full"), now))
+	assert.True(t, s.shedding(now))
+	assert.True(t, s.shedding(now.Add(59*time.Second)))
+	assert.False(t, s.shedding(now.Add(time.Minute)))
+
+	// Another capacity error extends the cooldown.
+	assert.True(t, s.observe(errors.New("sending queue is full"), now.Add(30*time.Second)))
+	assert.True(t, s.shedding(now.Add(time.Minute)))
+	assert.False(t, s.shedding(now.Add(90*time.Second)))
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// lookupEntries are the attributes of the lookup file, keyed by the namespaces and by the names of
+// the involved objects, either alone or prefixed with their namespace, e.g. `payments/web`.
+type lookupEntries struct {
+	Namespaces map[string]map[string]string `json:"namespaces"`
+	Objects    map[string]map[string]string `json:"objects"`
+}
+
+// lookupTable holds the entries of the lookup file, reloaded when the file is modified.
+type lookupTable struct {
+	path string
+
+	mu      sync.RWMutex
+	entries *lookupEntries
+	modTime time.Time
+}
+
+// newLookupTable loads the lookup file at path.
+func newLookupTable(path string) (*lookupTable, error) {
+	t := &lookupTable{path: path}
+	if _, err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// reload loads the lookup file again if it was modified since it was last loaded,
+// and reports whether it was. The entries are left as is when the file fails to load.
+func (t *lookupTable) reload() (bool, error) {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return false, err
+	}
+	t.mu.RLock()
+	unchanged := t.entries != nil && info.ModTime().Equal(t.modTime)
+	t.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return false, err
+	}
+	entries := &lookupEntries{}
+	if err := yaml.UnmarshalStrict(data, entries); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", t.path, err)
+	}
+	t.mu.Lock()
+	t.entries, t.modTime = entries, info.ModTime()
+	t.mu.Unlock()
+	return true, nil
+}
+
+// putAttributes puts the attributes of the namespace and of the involved object of ev into attrs,
+// the ones of the object taking precedence, and the namespaced ones among them.
+func (t *lookupTable) putAttributes(attrs pcommon.Map, ev *corev1.Event) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ns := ev.InvolvedObject.Namespace
+	if ns == "" {
+		ns = ev.Namespace
+	}
+	for k, v := range t.entries.Namespaces[ns] {
+		attrs.PutStr(k, v)
+	}
+	if ev.InvolvedObject.Name == "" {
+		return
+	}
+	for k, v := range t.entries.Objects[ev.InvolvedObject.Name] {
+		attrs.PutStr(k, v)
+	}
+	if ns != "" {
+		for k, v := range t.entries.Objects[ns+"/"+ev.InvolvedObject.Name] {
+			attrs.PutStr(k, v)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLookupTable(t *testing.T) {
+	table, err := newLookupTable(filepath.Join("testdata", "lookup.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+	}{
+		{
+			name:     "object",
+			object:   getEvent().InvolvedObject,
+			expected: map[string]any{"cost_center": "cc-42", "owner": "team-web"},
+		},
+		{
+			name:     "namespaced_object",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-2", Namespace: "test"},
+			expected: map[string]any{"cost_center": "cc-42", "owner": "platform", "tier": "frontend"},
+		},
+		{
+			name:     "object_of_other_namespace",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "test-34bcd-rn54", Namespace: "other"},
+			expected: map[string]any{"owner": "team-other"},
+		},
+		{
+			name:     "no_entry",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-3", Namespace: "unknown"},
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			attrs := pcommon.NewMap()
+			table.putAttributes(attrs, k8sEvent)
+			assert.Equal(t, tt.expected, attrs.AsRaw())
+		})
+	}
+}
+
+func TestLookupTableErrors(t *testing.T) {
+	_, err := newLookupTable(filepath.Join("testdata", "missing.yaml"))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "lookup.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("namespaces: [test]\n"), 0o600))
+	_, err = newLookupTable(path)
+	assert.ErrorContains(t, err, "failed to parse")
+}
+
+func TestLookupTableReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lookup.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`{"namespaces": {"test": {"owner": "platform"}}}`), 0o600))
+	table, err := newLookupTable(path)
+	require.NoError(t, err)
+
+	reloaded, err := table.reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	modified := time.Now().Add(time.Minute)
+	require.NoError(t, os.WriteFile(path, []byte("namespaces:\n  test:\n    owner: payments\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, modified, modified))
+	reloaded, err = table.reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	attrs := pcommon.NewMap()
+	table.putAttributes(attrs, getEvent())
+	assert.Equal(t, map[string]any{"owner": "payments"}, attrs.AsRaw())
+
+	// The attributes are kept when the modified file fails to load.
+	modified = modified.Add(time.Minute)
+	require.NoError(t, os.WriteFile(path, []byte("namespaces: [test]\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, modified, modified))
+	_, err = table.reload()
+	require.Error(t, err)
+	attrs = pcommon.NewMap()
+	table.putAttributes(attrs, getEvent())
+	assert.Equal(t, map[string]any{"owner": "payments"}, attrs.AsRaw())
+}
+
+func TestHandleEventWithLookupFile(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.LookupFile.Path = filepath.Join("testdata", "lookup.yaml")
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	owner, ok := attrs.Get("owner")
+	require.True(t, ok)
+	assert.Equal(t, "team-web", owner.Str())
+	costCenter, ok := attrs.Get("cost_center")
+	require.True(t, ok)
+	assert.Equal(t, "cc-42", costCenter.Str())
+}
+
+func TestStartWithMissingLookupFile(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.LookupFile.Path = filepath.Join("testdata", "missing.yaml")
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	err := recv.Start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorContains(t, err, "failed to load the lookup file")
+	require.NoError(t, recv.Shutdown(context.Background()))
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// accessibleNamespaces returns the namespaces whose events can be watched when the events of the
+// whole cluster can't, i.e. when listing them is forbidden, as reviewed by the API server for each
+// namespace of the cluster. It returns nil when the events of the whole cluster can be watched.
+func accessibleNamespaces(ctx context.Context, client k8s.Interface) ([]string, error) {
+	_, err := client.CoreV1().Events(corev1.NamespaceAll).List(ctx, metav1.ListOptions{Limit: 1})
+	if err == nil {
+		return nil, nil
+	}
+	if !apierrors.IsForbidden(err) {
+		return nil, fmt.Errorf("failed to list the events of all the namespaces: %w", err)
+	}
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the namespaces: %w", err)
+	}
+	var accessible []string
+	for _, ns := range namespaces.Items {
+		ok, err := canWatchEvents(ctx, client, ns.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			accessible = append(accessible, ns.Name)
+		}
+	}
+	if len(accessible) == 0 {
+		return nil, errors.New("the events of none of the namespaces can be watched")
+	}
+	return accessible, nil
+}
+
+// canWatchEvents reports whether the events of namespace ns can be both listed and watched.
+func canWatchEvents(ctx context.Context, client k8s.Interface, ns string) (bool, error) {
+	for _, verb := range []string{"list", "watch"} {
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: ns,
+					Verb:      verb,
+					Resource:  "events",
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to review the access to the events of namespace %q: %w", ns, err)
+		}
+		if !review.Status.Allowed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newRestrictedClient returns a fake client allowed to list and watch the events of the allowed
+// namespaces only, among the namespaces "default", "team-a" and "team-b".
+func newRestrictedClient(allowed ...string) *fake.Clientset {
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+	)
+	client.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ns := action.GetNamespace()
+		for _, a := range allowed {
+			if ns == a {
+				return false, nil, nil
+			}
+		}
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "events"}, "", errors.New("access denied"))
+	})
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		for _, a := range allowed {
+			if review.Spec.ResourceAttributes.Namespace == a {
+				review.Status.Allowed = true
+			}
+		}
+		return true, review, nil
+	})
+	return client
+}
+
+func TestAccessibleNamespaces(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      func() *fake.Clientset
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:   "cluster_wide_access",
+			client: func() *fake.Clientset { return newRestrictedClient(corev1.NamespaceAll) },
+		},
+		{
+			name:     "namespaced_access",
+			client:   func() *fake.Clientset { return newRestrictedClient("team-a", "team-b") },
+			expected: []string{"team-a", "team-b"},
+		},
+		{
+			name:        "no_access",
+			client:      func() *fake.Clientset { return newRestrictedClient() },
+			expectedErr: "the events of none of the namespaces can be watched",
+		},
+		{
+			name: "namespaces_forbidden",
+			client: func() *fake.Clientset {
+				client := newRestrictedClient("team-a")
+				client.PrependReactor("list", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("access denied"))
+				})
+				return client
+			},
+			expectedErr: "failed to list the namespaces",
+		},
+		{
+			name: "list_error",
+			client: func() *fake.Clientset {
+				client := fake.NewClientset()
+				client.PrependReactor("list", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("connection refused")
+				})
+				return client
+			},
+			expectedErr: "failed to list the events of all the namespaces: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespaces, err := accessibleNamespaces(context.Background(), tt.client())
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, namespaces)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// cachedKind describes how the objects of a kind are watched and looked up.
+type cachedKind struct {
+	namespaced bool
+	informer   func(factory informers.SharedInformerFactory) cache.SharedIndexInformer
+}
+
+// cachedKinds are the kinds of involved objects that can be cached for the enrichment.
+var cachedKinds = map[string]cachedKind{
+	"Pod": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Pods().Informer()
+		},
+	},
+	"Node": {
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Nodes().Informer()
+		},
+	},
+	"Namespace": {
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Namespaces().Informer()
+		},
+	},
+	"Service": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Services().Informer()
+		},
+	},
+	"Endpoints": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().Endpoints().Informer()
+		},
+	},
+	"PersistentVolumeClaim": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().PersistentVolumeClaims().Informer()
+		},
+	},
+	"PersistentVolume": {
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().PersistentVolumes().Informer()
+		},
+	},
+	"ResourceQuota": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Core().V1().ResourceQuotas().Informer()
+		},
+	},
+	"Deployment": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().Deployments().Informer()
+		},
+	},
+	"ReplicaSet": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().ReplicaSets().Informer()
+		},
+	},
+	"StatefulSet": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().StatefulSets().Informer()
+		},
+	},
+	"DaemonSet": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Apps().V1().DaemonSets().Informer()
+		},
+	},
+	"Job": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Batch().V1().Jobs().Informer()
+		},
+	},
+	"CronJob": {
+		namespaced: true,
+		informer: func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+			return f.Batch().V1().CronJobs().Informer()
+		},
+	},
+}
+
+// objectCache caches the objects involved in the events using shared informers,
+// so that the events can be enriched without querying the API server.
+type objectCache struct {
+	factory   informers.SharedInformerFactory
+	informers map[string]cache.SharedIndexInformer
+}
+
+// newObjectCache creates the informers for the given kinds. The informers
+// only start watching the API server once start is called.
+func newObjectCache(client k8s.Interface, kinds []string) (*objectCache, error) {
+	factory := informers.NewSharedInformerFactory(client, 0)
+	c := &objectCache{
+		factory:   factory,
+		informers: make(map[string]cache.SharedIndexInformer, len(kinds)),
+	}
+	for _, kind := range kinds {
+		ck, ok := cachedKinds[kind]
+		if !ok {
+			return nil, fmt.Errorf("unsupported kind %q", kind)
+		}
+		c.informers[kind] = ck.informer(factory)
+	}
+	return c, nil
+}
+
+// start starts watching the cached kinds until stopCh is closed.
+func (c *objectCache) start(stopCh <-chan struct{}) {
+	c.factory.Start(stopCh)
+}
+
+// caches reports whether the objects of kind are cached.
+func (c *objectCache) caches(kind string) bool {
+	_, ok := c.informers[kind]
+	return ok
+}
+
+// waitForSync waits for the cache of kind to be synced until ctx is done.
+// It reports whether the cache is synced.
+func (c *objectCache) waitForSync(ctx context.Context, kind string) bool {
+	informer, ok := c.informers[kind]
+	if !ok {
+		return false
+	}
+	if informer.HasSynced() {
+		return true
+	}
+	err := wait.PollUntilContextCancel(ctx, 10*time.Millisecond, false, func(context.Context) (bool, error) {
+		return informer.HasSynced(), nil
+	})
+	return err == nil
+}
+
+// get returns the cached object referenced by ref. Objects that have been
+// recreated under the same name are not returned, since they are not the
+// object the event is about.
+func (c *objectCache) get(ref *corev1.ObjectReference) (runtime.Object, bool) {
+	informer, ok := c.informers[ref.Kind]
+	if !ok {
+		return nil, false
+	}
+	key := ref.Name
+	if cachedKinds[ref.Kind].namespaced {
+		key = ref.Namespace + "/" + ref.Name
+	}
+	item, exists, err := informer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, false
+	}
+	obj, ok := item.(runtime.Object)
+	if !ok {
+		return nil, false
+	}
+	if ref.UID != "" {
+		accessor, err := meta.Accessor(obj)
+		if err != nil || accessor.GetUID() != ref.UID {
+			return nil, false
+		}
+	}
+	return obj, true
+}
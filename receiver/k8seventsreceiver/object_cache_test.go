@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestObjectCache(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+		},
+	}
+	node := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "testHost",
+			UID:  types.UID("5a3b-ffe1"),
+		},
+	}
+	c := newTestObjectCache(t, []string{"Pod", "Node"}, pod, node)
+
+	obj, ok := c.get(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "test-34bcd-rn54", UID: "059f3edc-b5a9"})
+	require.True(t, ok)
+	assert.Equal(t, pod, obj)
+
+	// The UID is optional in the reference.
+	_, ok = c.get(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "test-34bcd-rn54"})
+	assert.True(t, ok)
+
+	// The namespace is ignored for cluster scoped kinds.
+	obj, ok = c.get(&corev1.ObjectReference{Kind: "Node", Namespace: "default", Name: "testHost"})
+	require.True(t, ok)
+	assert.Equal(t, node, obj)
+
+	// A recreated object with the same name is a different object.
+	_, ok = c.get(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "test-34bcd-rn54", UID: "e2f1-0d0c"})
+	assert.False(t, ok)
+
+	_, ok = c.get(&corev1.ObjectReference{Kind: "Pod", Namespace: "other", Name: "test-34bcd-rn54"})
+	assert.False(t, ok)
+
+	_, ok = c.get(&corev1.ObjectReference{Kind: "Deployment", Namespace: "test", Name: "test"})
+	assert.False(t, ok)
+}
+
+func TestNewObjectCacheUnsupportedKind(t *testing.T) {
+	_, err := newObjectCache(fake.NewClientset(), []string{"Pod", "Secret"})
+	assert.EqualError(t, err, `unsupported kind "Secret"`)
+}
+
+// newTestObjectCache returns a synced cache of the given kinds populated with objects.
+func newTestObjectCache(t *testing.T, kinds []string, objects ...runtime.Object) *objectCache {
+	c, err := newObjectCache(fake.NewClientset(objects...), kinds)
+	require.NoError(t, err)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	c.start(stopCh)
+	for kind, synced := range c.factory.WaitForCacheSync(stopCh) {
+		require.True(t, synced, "cache of %v not synced", kind)
+	}
+	return c
+}
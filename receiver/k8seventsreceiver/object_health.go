@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// attributeObjectHealth is the health of the involved object derived from its conditions.
+const attributeObjectHealth = "k8s.object.health"
+
+const (
+	objectHealthHealthy  = "healthy"
+	objectHealthDegraded = "degraded"
+	objectHealthUnknown  = "unknown"
+)
+
+// nodePressureConditions are the conditions of the nodes degrading the ready ones when true.
+var nodePressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+	corev1.NodeNetworkUnavailable,
+}
+
+// objectHealth derives the health of obj from its conditions. It returns false for the kinds without
+// conditions and the objects whose conditions aren't reported yet.
+func objectHealth(obj runtime.Object) (string, bool) {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return deploymentHealth(o)
+	case *corev1.Node:
+		return nodeHealth(o)
+	case *corev1.Pod:
+		return podHealth(o)
+	default:
+		return "", false
+	}
+}
+
+// deploymentHealth is degraded when the Deployment is unavailable, failed to progress or to create
+// its replicas, and healthy when it is available.
+func deploymentHealth(d *appsv1.Deployment) (string, bool) {
+	if len(d.Status.Conditions) == 0 {
+		return "", false
+	}
+	available := corev1.ConditionUnknown
+	for _, c := range d.Status.Conditions {
+		switch {
+		case c.Type == appsv1.DeploymentAvailable:
+			available = c.Status
+		case c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse,
+			c.Type == appsv1.DeploymentReplicaFailure && c.Status == corev1.ConditionTrue:
+			return objectHealthDegraded, true
+		}
+	}
+	return conditionHealth(available), true
+}
+
+// nodeHealth is the readiness of the node, ready nodes under pressure being degraded.
+// The readiness of the nodes not heard of by the node controller is unknown.
+func nodeHealth(n *corev1.Node) (string, bool) {
+	ready, ok := corev1.ConditionStatus(""), false
+	pressure := false
+	for _, c := range n.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			ready, ok = c.Status, true
+		}
+		for _, t := range nodePressureConditions {
+			if c.Type == t && c.Status == corev1.ConditionTrue {
+				pressure = true
+			}
+		}
+	}
+	if !ok {
+		return "", false
+	}
+	if ready == corev1.ConditionTrue && pressure {
+		return objectHealthDegraded, true
+	}
+	return conditionHealth(ready), true
+}
+
+// podHealth is the readiness of the pod, the pods which completed successfully being healthy
+// and the failed ones degraded although none of them is ready.
+func podHealth(p *corev1.Pod) (string, bool) {
+	switch p.Status.Phase {
+	case corev1.PodSucceeded:
+		return objectHealthHealthy, true
+	case corev1.PodFailed:
+		return objectHealthDegraded, true
+	}
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return conditionHealth(c.Status), true
+		}
+	}
+	return "", false
+}
+
+// conditionHealth maps the status of a condition whose truth means healthy to a health.
+func conditionHealth(status corev1.ConditionStatus) string {
+	switch status {
+	case corev1.ConditionTrue:
+		return objectHealthHealthy
+	case corev1.ConditionFalse:
+		return objectHealthDegraded
+	default:
+		return objectHealthUnknown
+	}
+}
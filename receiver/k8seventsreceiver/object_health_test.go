@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestObjectHealth(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected string
+	}{
+		{
+			name: "available_deployment",
+			obj: &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue},
+			}}},
+			expected: objectHealthHealthy,
+		},
+		{
+			name: "unavailable_deployment",
+			obj: &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "deployment_exceeding_progress_deadline",
+			obj: &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "deployment_failing_replicas",
+			obj: &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionTrue},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "deployment_without_availability",
+			obj: &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue},
+			}}},
+			expected: objectHealthUnknown,
+		},
+		{
+			name: "ready_node",
+			obj: &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+			}}},
+			expected: objectHealthHealthy,
+		},
+		{
+			name: "ready_node_under_pressure",
+			obj: &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "not_ready_node",
+			obj: &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionFalse},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "unreachable_node",
+			obj: &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionUnknown},
+			}}},
+			expected: objectHealthUnknown,
+		},
+		{
+			name: "ready_pod",
+			obj: &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			}}},
+			expected: objectHealthHealthy,
+		},
+		{
+			name: "not_ready_pod",
+			obj: &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			}}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name:     "succeeded_pod",
+			obj:      &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+			expected: objectHealthHealthy,
+		},
+		{
+			name:     "failed_pod",
+			obj:      &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			expected: objectHealthDegraded,
+		},
+		{
+			name: "deployment_without_conditions",
+			obj:  &appsv1.Deployment{},
+		},
+		{
+			name: "node_without_readiness",
+			obj:  &corev1.Node{},
+		},
+		{
+			name: "pending_pod",
+			obj:  &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}},
+		},
+		{
+			name: "kind_without_conditions",
+			obj:  &corev1.Service{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, ok := objectHealth(tt.obj)
+			assert.Equal(t, tt.expected != "", ok)
+			assert.Equal(t, tt.expected, health)
+		})
+	}
+}
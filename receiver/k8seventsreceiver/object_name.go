@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"regexp"
+)
+
+// attributeObjectNameBase is the name of the involved object without its generated suffixes,
+// i.e. the name of the workload the object belongs to.
+const attributeObjectNameBase = "k8s.event.object.name.base"
+
+// The generated suffixes of the names are drawn from the alphabet of the API server, without vowels
+// nor the digits looking like them, so that the words of the names aren't taken for suffixes.
+const generatedSuffixChars = "[bcdfghjklmnpqrstvwxz2456789]"
+
+var (
+	// replicaSetPodNameRegexp matches the names of the pods of the ReplicaSets, i.e. the name of the
+	// Deployment, the hash of the pod template and a random suffix, e.g. `web-7d9f8c6b5-x2kq4`.
+	replicaSetPodNameRegexp = regexp.MustCompile(`^(.+)-` + generatedSuffixChars + `{6,10}-` + generatedSuffixChars + `{5}$`)
+
+	// replicaSetNameRegexp matches the names of the ReplicaSets of the Deployments, e.g. `web-7d9f8c6b5`.
+	replicaSetNameRegexp = regexp.MustCompile(`^(.+)-` + generatedSuffixChars + `{6,10}$`)
+
+	// statefulSetPodNameRegexp matches the names of the pods of the StatefulSets, e.g. `db-0`.
+	statefulSetPodNameRegexp = regexp.MustCompile(`^(.+)-\d+$`)
+
+	// generatedPodNameRegexp matches the names of the pods with a random suffix,
+	// e.g. the pods of the DaemonSets and the Jobs, e.g. `node-exporter-x2kq4`.
+	generatedPodNameRegexp = regexp.MustCompile(`^(.+)-` + generatedSuffixChars + `{5}$`)
+)
+
+// baseObjectName strips the generated suffixes of the pods and the ReplicaSets from name, guessing
+// them from the usual naming of the workloads. The names of the other kinds are left as is.
+func baseObjectName(kind, name string) string {
+	var regexps []*regexp.Regexp
+	switch kind {
+	case "Pod":
+		regexps = []*regexp.Regexp{replicaSetPodNameRegexp, statefulSetPodNameRegexp, generatedPodNameRegexp}
+	case "ReplicaSet":
+		regexps = []*regexp.Regexp{replicaSetNameRegexp}
+	}
+	for _, re := range regexps {
+		if m := re.FindStringSubmatch(name); m != nil {
+			return m[1]
+		}
+	}
+	return name
+}
+
+// workloadOwnerKinds are the kinds of the controllers of the pods and the ReplicaSets naming their workload.
+var workloadOwnerKinds = map[string]bool{
+	"ReplicaSet":  true,
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"Job":         true,
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBaseObjectName(t *testing.T) {
+	tests := []struct {
+		kind     string
+		name     string
+		expected string
+	}{
+		{kind: "Pod", name: "web-7d9f8c6b5-x2kq4", expected: "web"},
+		{kind: "Pod", name: "payments-api-5b8d7c9f4-bq2zr", expected: "payments-api"},
+		{kind: "Pod", name: "db-0", expected: "db"},
+		{kind: "Pod", name: "kafka-broker-12", expected: "kafka-broker"},
+		{kind: "Pod", name: "node-exporter-x2kq4", expected: "node-exporter"},
+		{kind: "Pod", name: "web-frontend", expected: "web-frontend"},
+		{kind: "Pod", name: "etcd", expected: "etcd"},
+		{kind: "ReplicaSet", name: "web-7d9f8c6b5", expected: "web"},
+		{kind: "ReplicaSet", name: "web-backend", expected: "web-backend"},
+		{kind: "Deployment", name: "web-7d9f8c6b5", expected: "web-7d9f8c6b5"},
+		{kind: "Node", name: "node-1", expected: "node-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind+"/"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, baseObjectName(tt.kind, tt.name))
+		})
+	}
+}
+
+func TestHandleEventWithStripGeneratedSuffix(t *testing.T) {
+	isController := true
+	// The Deployment isn't named after the prefix of its pods, which only the owners tell.
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-7d9f8c6b5",
+			Namespace: "test",
+			UID:       types.UID("a1b2-c3d4"),
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "Deployment", Name: "frontend", UID: types.UID("d1e2-f3a4"), Controller: &isController},
+			},
+		},
+	}
+	deploymentPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-7d9f8c6b5-x2kq4",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "web-7d9f8c6b5", UID: types.UID("a1b2-c3d4"), Controller: &isController},
+			},
+		},
+	}
+	statefulSetPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "db-0",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "StatefulSet", Name: "db", Controller: &isController},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		enrichment bool
+		object     corev1.ObjectReference
+		expected   string
+	}{
+		{
+			name:       "deployment_pod_owners",
+			enrichment: true,
+			object:     corev1.ObjectReference{Kind: "Pod", Name: "web-7d9f8c6b5-x2kq4", Namespace: "test"},
+			expected:   "frontend",
+		},
+		{
+			name:     "deployment_pod_heuristic",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-7d9f8c6b5-x2kq4", Namespace: "test"},
+			expected: "web",
+		},
+		{
+			name:       "statefulset_pod_owners",
+			enrichment: true,
+			object:     corev1.ObjectReference{Kind: "Pod", Name: "db-0", Namespace: "test"},
+			expected:   "db",
+		},
+		{
+			name:     "statefulset_pod_heuristic",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "db-0", Namespace: "test"},
+			expected: "db",
+		},
+		{
+			name:       "pod_not_cached",
+			enrichment: true,
+			object:     corev1.ObjectReference{Kind: "Pod", Name: "api-5b8d7c9f4-bq2zr", Namespace: "test"},
+			expected:   "api",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.StripGeneratedSuffix = true
+			sink := new(consumertest.LogsSink)
+			recv := newTestReceiver(t, rCfg, sink)
+			if tt.enrichment {
+				recv.objectCache = newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, rs, deploymentPod, statefulSetPod)
+			}
+
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			base, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeObjectNameBase)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, base.Str())
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// maxOwnerChainDepth bounds the walk of the owners, e.g. a pod, its Job and their CronJob,
+// so that cyclic owner references don't loop forever.
+const maxOwnerChainDepth = 5
+
+// ownerChain returns the controllers of the cached object referenced by ref, from its own controller
+// up, as far as they are cached, e.g. the ReplicaSet and the Deployment of a pod. The chain is
+// complete unless the walk stopped on an object of a cached kind missing from the cache, e.g. a
+// ReplicaSet not yet cached when the first events of its pods are received.
+func (c *objectCache) ownerChain(ref *corev1.ObjectReference) ([]corev1.ObjectReference, bool) {
+	var chain []corev1.ObjectReference
+	cur := *ref
+	for len(chain) < maxOwnerChainDepth {
+		if _, ok := c.informers[cur.Kind]; !ok {
+			// The owners of the kinds that aren't cached are never known.
+			break
+		}
+		obj, ok := c.get(&cur)
+		if !ok {
+			return chain, false
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			break
+		}
+		owner := metav1.GetControllerOfNoCopy(accessor)
+		if owner == nil {
+			break
+		}
+		cur = corev1.ObjectReference{
+			Kind:       owner.Kind,
+			Namespace:  ref.Namespace,
+			Name:       owner.Name,
+			UID:        owner.UID,
+			APIVersion: owner.APIVersion,
+		}
+		chain = append(chain, cur)
+	}
+	return chain, true
+}
+
+// deploymentOf returns the name of the deployment the object referenced by ref with the owners
+// chain belongs to: the deployment itself, or the deployment of a replica set or of its pods.
+func deploymentOf(ref *corev1.ObjectReference, chain []corev1.ObjectReference) (string, bool) {
+	switch ref.Kind {
+	case "Deployment":
+		return ref.Name, true
+	case "ReplicaSet":
+		if len(chain) > 0 && chain[0].Kind == "Deployment" {
+			return chain[0].Name, true
+		}
+	case "Pod":
+		if len(chain) > 1 && chain[0].Kind == "ReplicaSet" && chain[1].Kind == "Deployment" {
+			return chain[1].Name, true
+		}
+	}
+	return "", false
+}
+
+// ownerChainEntry is the owners chain of an object resolved at a time.
+type ownerChainEntry struct {
+	uid      types.UID
+	chain    []corev1.ObjectReference
+	resolved time.Time
+}
+
+// ownerChainCache caches the owners chains of the objects by UID for the ttl, up to maxEntries,
+// evicting the least recently used ones first when full, so that the events repeatedly reported
+// about the same objects don't walk their owners every time. The walks of the chains missing from
+// the cache are limited to maxConcurrency at a time.
+type ownerChainCache struct {
+	ttl        time.Duration
+	maxEntries int
+	walks      chan struct{}
+
+	mu      sync.Mutex
+	entries map[types.UID]*list.Element
+	order   *list.List
+}
+
+func newOwnerChainCache(ttl time.Duration, maxEntries, maxConcurrency int) *ownerChainCache {
+	return &ownerChainCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		walks:      make(chan struct{}, maxConcurrency),
+		entries:    make(map[types.UID]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// resolve returns the owners chain of the object uid at now, walking it with walk unless cached
+// and not expired, and reports whether it was cached. Only the chains walk reports as complete
+// are cached, so that the owners missing from the cache are looked up again by the next events.
+func (c *ownerChainCache) resolve(uid types.UID, now time.Time, walk func() ([]corev1.ObjectReference, bool)) ([]corev1.ObjectReference, bool) {
+	if chain, ok := c.get(uid, now); ok {
+		return chain, true
+	}
+	c.walks <- struct{}{}
+	chain, complete := walk()
+	<-c.walks
+	if complete {
+		c.put(uid, chain, now)
+	}
+	return chain, false
+}
+
+func (c *ownerChainCache) get(uid types.UID, now time.Time) ([]corev1.ObjectReference, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uid]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*ownerChainEntry)
+	if now.Sub(entry.resolved) >= c.ttl {
+		c.order.Remove(e)
+		delete(c.entries, uid)
+		return nil, false
+	}
+	c.order.MoveToBack(e)
+	return entry.chain, true
+}
+
+func (c *ownerChainCache) put(uid types.UID, chain []corev1.ObjectReference, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[uid]; ok {
+		// Resolved concurrently by another event.
+		c.order.MoveToBack(e)
+		entry := e.Value.(*ownerChainEntry)
+		entry.chain, entry.resolved = chain, now
+		return
+	}
+	if c.order.Len() >= c.maxEntries {
+		oldest := c.order.Front()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*ownerChainEntry).uid)
+	}
+	c.entries[uid] = c.order.PushBack(&ownerChainEntry{uid: uid, chain: chain, resolved: now})
+}
+
+// invalidate forgets the owners chain of the object uid, e.g. once the object is deleted.
+func (c *ownerChainCache) invalidate(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[uid]; ok {
+		c.order.Remove(e)
+		delete(c.entries, uid)
+	}
+}
+
+// invalidateOwnerChains calls invalidate with the UIDs of the cached objects once they are added,
+// deleted or their controller changes, e.g. once a pod is orphaned or adopted.
+func (c *objectCache) invalidateOwnerChains(invalidate func(uid types.UID)) error {
+	for _, informer := range c.informers {
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				if accessor, err := meta.Accessor(obj); err == nil {
+					invalidate(accessor.GetUID())
+				}
+			},
+			UpdateFunc: func(oldObj, newObj any) {
+				oldAccessor, err := meta.Accessor(oldObj)
+				if err != nil {
+					return
+				}
+				newAccessor, err := meta.Accessor(newObj)
+				if err != nil {
+					return
+				}
+				if controllerUID(oldAccessor) != controllerUID(newAccessor) {
+					invalidate(newAccessor.GetUID())
+				}
+			},
+			DeleteFunc: func(obj any) {
+				if accessor, err := meta.Accessor(unwrapTombstone(obj)); err == nil {
+					invalidate(accessor.GetUID())
+				}
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func controllerUID(obj metav1.Object) types.UID {
+	if owner := metav1.GetControllerOfNoCopy(obj); owner != nil {
+		return owner.UID
+	}
+	return ""
+}
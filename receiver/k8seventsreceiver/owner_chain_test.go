@@ -0,0 +1,376 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadatatest"
+)
+
+func TestOwnerChainCache(t *testing.T) {
+	c := newOwnerChainCache(time.Minute, 2, 1)
+	now := time.Now()
+	walks := 0
+	walk := func() ([]corev1.ObjectReference, bool) {
+		walks++
+		return []corev1.ObjectReference{{Kind: "ReplicaSet", Name: "web-5d4f8"}}, true
+	}
+
+	chain, hit := c.resolve("a", now, walk)
+	assert.False(t, hit)
+	assert.Equal(t, []corev1.ObjectReference{{Kind: "ReplicaSet", Name: "web-5d4f8"}}, chain)
+	chain, hit = c.resolve("a", now.Add(30*time.Second), walk)
+	assert.True(t, hit)
+	assert.Equal(t, []corev1.ObjectReference{{Kind: "ReplicaSet", Name: "web-5d4f8"}}, chain)
+	assert.Equal(t, 1, walks)
+
+	// The chains expire after the ttl.
+	_, hit = c.resolve("a", now.Add(time.Minute), walk)
+	assert.False(t, hit)
+	assert.Equal(t, 2, walks)
+
+	// The least recently used chain is evicted once full.
+	_, hit = c.resolve("b", now.Add(time.Minute), walk)
+	assert.False(t, hit)
+	_, hit = c.resolve("a", now.Add(time.Minute), walk)
+	assert.True(t, hit)
+	_, hit = c.resolve("c", now.Add(time.Minute), walk)
+	assert.False(t, hit)
+	_, hit = c.resolve("a", now.Add(time.Minute), walk)
+	assert.True(t, hit)
+	_, hit = c.resolve("b", now.Add(time.Minute), walk)
+	assert.False(t, hit)
+	assert.Equal(t, 2, c.order.Len())
+
+	c.invalidate("b")
+	_, hit = c.resolve("b", now.Add(time.Minute), walk)
+	assert.False(t, hit)
+
+	// The incomplete chains aren't cached.
+	partial := func() ([]corev1.ObjectReference, bool) {
+		walks++
+		return nil, false
+	}
+	walks = 0
+	_, hit = c.resolve("d", now.Add(time.Minute), partial)
+	assert.False(t, hit)
+	_, hit = c.resolve("d", now.Add(time.Minute), partial)
+	assert.False(t, hit)
+	assert.Equal(t, 2, walks)
+	_, hit = c.resolve("a", now.Add(time.Minute), walk)
+	assert.True(t, hit)
+}
+
+func TestOwnerChainCacheMaxConcurrency(t *testing.T) {
+	c := newOwnerChainCache(time.Minute, 100, 2)
+	var walking, maxWalking atomic.Int64
+	walk := func() ([]corev1.ObjectReference, bool) {
+		n := walking.Add(1)
+		for {
+			m := maxWalking.Load()
+			if n <= m || maxWalking.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		walking.Add(-1)
+		return nil, true
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(uid types.UID) {
+			defer wg.Done()
+			c.resolve(uid, time.Now(), walk)
+		}(types.UID(rune('a' + i)))
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, maxWalking.Load(), int64(2))
+}
+
+func TestObjectCacheOwnerChain(t *testing.T) {
+	rs, pod := newTestWorkload()
+	c := newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, rs, pod)
+
+	chain, complete := c.ownerChain(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "web-5d4f8-x9z2"})
+	assert.True(t, complete)
+	assert.Equal(t, []corev1.ObjectReference{
+		{Kind: "ReplicaSet", Namespace: "test", Name: "web-5d4f8", UID: "a1b2-c3d4", APIVersion: "apps/v1"},
+		{Kind: "Deployment", Namespace: "test", Name: "web", UID: "d1e2-f3a4", APIVersion: "apps/v1"},
+	}, chain)
+
+	chain, complete = c.ownerChain(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "unknown"})
+	assert.False(t, complete)
+	assert.Empty(t, chain)
+
+	// The owners of the kinds that aren't cached are never known.
+	chain, complete = c.ownerChain(&corev1.ObjectReference{Kind: "Node", Name: "testHost"})
+	assert.True(t, complete)
+	assert.Empty(t, chain)
+
+	// The chain stops on the ReplicaSet not yet cached.
+	c = newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, pod)
+	chain, complete = c.ownerChain(&corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "web-5d4f8-x9z2"})
+	assert.False(t, complete)
+	assert.Equal(t, []corev1.ObjectReference{
+		{Kind: "ReplicaSet", Namespace: "test", Name: "web-5d4f8", UID: "a1b2-c3d4", APIVersion: "apps/v1"},
+	}, chain)
+}
+
+func TestDeploymentOf(t *testing.T) {
+	isController := true
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8",
+			Namespace: "test",
+			UID:       types.UID("a1b2-c3d4"),
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "Deployment", Name: "web", UID: types.UID("d1e2-f3a4"), Controller: &isController},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8-x9z2",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "web-5d4f8", UID: types.UID("a1b2-c3d4"), Controller: &isController},
+			},
+		},
+	}
+	orphan := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "orphan",
+			Namespace: "test",
+		},
+	}
+	c := newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, rs, pod, orphan)
+
+	tests := []struct {
+		name     string
+		ref      corev1.ObjectReference
+		expected string
+		ok       bool
+	}{
+		{name: "deployment", ref: corev1.ObjectReference{Kind: "Deployment", Namespace: "test", Name: "web"}, expected: "web", ok: true},
+		{name: "replica_set", ref: corev1.ObjectReference{Kind: "ReplicaSet", Namespace: "test", Name: "web-5d4f8"}, expected: "web", ok: true},
+		{name: "pod", ref: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "web-5d4f8-x9z2"}, expected: "web", ok: true},
+		{name: "orphan_pod", ref: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "orphan"}},
+		{name: "not_cached", ref: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "unknown"}},
+		{name: "other_kind", ref: corev1.ObjectReference{Kind: "Node", Name: "testHost"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, _ := c.ownerChain(&tt.ref)
+			name, ok := deploymentOf(&tt.ref, chain)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestInvalidateOwnerChains(t *testing.T) {
+	rs, pod := newTestWorkload()
+	client := fake.NewClientset(rs, pod)
+	c, err := newObjectCache(client, []string{"Pod", "ReplicaSet"})
+	require.NoError(t, err)
+	invalidated := make(chan types.UID, 10)
+	require.NoError(t, c.invalidateOwnerChains(func(uid types.UID) { invalidated <- uid }))
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	c.start(stopCh)
+	for kind, synced := range c.factory.WaitForCacheSync(stopCh) {
+		require.True(t, synced, "cache of %v not synced", kind)
+	}
+
+	// The chains of the added objects are invalidated.
+	var added []types.UID
+	for len(added) < 2 {
+		select {
+		case uid := <-invalidated:
+			added = append(added, uid)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the chains of the added objects weren't invalidated")
+		}
+	}
+	assert.ElementsMatch(t, []types.UID{pod.UID, rs.UID}, added)
+
+	// Updates keeping the controller are ignored.
+	pods := client.CoreV1().Pods("test")
+	pod.Labels = map[string]string{"app": "web"}
+	_, err = pods.Update(context.Background(), pod, v1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// The chain of an orphaned pod is invalidated.
+	pod = pod.DeepCopy()
+	pod.OwnerReferences = nil
+	_, err = pods.Update(context.Background(), pod, v1.UpdateOptions{})
+	require.NoError(t, err)
+	select {
+	case uid := <-invalidated:
+		assert.Equal(t, pod.UID, uid)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the chain of the orphaned pod wasn't invalidated")
+	}
+
+	require.NoError(t, client.AppsV1().ReplicaSets("test").Delete(context.Background(), rs.Name, v1.DeleteOptions{}))
+	select {
+	case uid := <-invalidated:
+		assert.Equal(t, rs.UID, uid)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the chain of the deleted replica set wasn't invalidated")
+	}
+}
+
+func TestHandleEventWithOwnerChainCache(t *testing.T) {
+	rs, pod := newTestWorkload()
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "ReplicaSet"}
+	rCfg.Enrichment.OwnerChainCache.Enabled = true
+	rCfg.StripGeneratedSuffix = true
+	rCfg.WorkloadSelector.Deployments = []string{"test/web"}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, sink)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+	recv.objectCache = newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, rs, pod)
+
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.Name = pod.Name
+	k8sEvent.InvolvedObject.UID = pod.UID
+	recv.handleEvent(k8sEvent)
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 2, sink.LogRecordCount())
+	for _, ld := range sink.AllLogs() {
+		name, ok := ld.ResourceLogs().At(0).Resource().Attributes().Get("k8s.deployment.name")
+		require.True(t, ok)
+		assert.Equal(t, "web", name.Str())
+		base, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeObjectNameBase)
+		require.True(t, ok)
+		assert.Equal(t, "web", base.Str())
+	}
+
+	// The workload selector, the deployment and the name of the workload are all resolved from
+	// the chain walked by the first lookup.
+	metadatatest.AssertEqualK8seventsOwnerCacheMisses(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 1}}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualK8seventsOwnerCacheHits(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 5}}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestHandleEventWithOwnerChainCacheBeforeOwnerCached(t *testing.T) {
+	rs, pod := newTestWorkload()
+	client := fake.NewClientset(pod)
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "ReplicaSet"}
+	rCfg.Enrichment.OwnerChainCache.Enabled = true
+	rCfg.WorkloadSelector.Deployments = []string{"test/web"}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.ctx = context.Background()
+	c, err := newObjectCache(client, rCfg.Enrichment.Kinds)
+	require.NoError(t, err)
+	require.NoError(t, c.invalidateOwnerChains(recv.owners.invalidate))
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	c.start(stopCh)
+	for kind, synced := range c.factory.WaitForCacheSync(stopCh) {
+		require.True(t, synced, "cache of %v not synced", kind)
+	}
+	recv.objectCache = c
+
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.Name = pod.Name
+	k8sEvent.InvolvedObject.UID = pod.UID
+	// The deployment of the pod isn't known until its ReplicaSet is cached.
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 0, sink.LogRecordCount())
+
+	// The chain stopped on the ReplicaSet missing from the cache is walked again once it's cached.
+	_, err = client.AppsV1().ReplicaSets("test").Create(context.Background(), rs, v1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, ok := c.get(&corev1.ObjectReference{Kind: "ReplicaSet", Namespace: "test", Name: rs.Name, UID: rs.UID})
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	name, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("k8s.deployment.name")
+	require.True(t, ok)
+	assert.Equal(t, "web", name.Str())
+}
+
+func BenchmarkOwnerChain(b *testing.B) {
+	rs, pod := newTestWorkload()
+	c, err := newObjectCache(fake.NewClientset(rs, pod), []string{"Pod", "ReplicaSet"})
+	require.NoError(b, err)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.start(stopCh)
+	c.factory.WaitForCacheSync(stopCh)
+	ref := &corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: pod.Name, UID: pod.UID}
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c.ownerChain(ref)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		owners := newOwnerChainCache(time.Hour, 10, 1)
+		walk := func() ([]corev1.ObjectReference, bool) { return c.ownerChain(ref) }
+		now := time.Now()
+		for i := 0; i < b.N; i++ {
+			owners.resolve(ref.UID, now, walk)
+		}
+	})
+}
+
+// newTestWorkload returns a pod controlled by a ReplicaSet of the Deployment web.
+func newTestWorkload() (*appsv1.ReplicaSet, *corev1.Pod) {
+	isController := true
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8",
+			Namespace: "test",
+			UID:       types.UID("a1b2-c3d4"),
+			OwnerReferences: []v1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: types.UID("d1e2-f3a4"), Controller: &isController},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8-x9z2",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+			OwnerReferences: []v1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d4f8", UID: types.UID("a1b2-c3d4"), Controller: &isController},
+			},
+		},
+	}
+	return rs, pod
+}
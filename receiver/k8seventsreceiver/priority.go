@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// attributePriority is the triage priority of the event, from 0 to 100.
+const attributePriority = "k8s.event.priority"
+
+// eventPriority scores the triage priority of the event at now, from 0 to 100, as the weighted
+// mean of the factors below, each from 0 to 1:
+//   - type: 1 for the Warning events, 0 otherwise,
+//   - reason: 1 for the critical reasons, 0 otherwise,
+//   - count: the logarithm of the number of occurrences, relative to the saturation count,
+//   - recency: the time since the last occurrence, decreasing linearly to 0 over the recency window.
+func (cfg *PriorityConfig) eventPriority(ev *corev1.Event, now time.Time) int64 {
+	w := cfg.Weights
+	total := w.Type + w.Reason + w.Count + w.Recency
+	if total <= 0 {
+		return 0
+	}
+	var score float64
+	if strings.EqualFold(ev.Type, corev1.EventTypeWarning) {
+		score += w.Type
+	}
+	if slices.Contains(cfg.CriticalReasons, ev.Reason) {
+		score += w.Reason
+	}
+	if count := eventCount(ev); count > 1 && cfg.SaturationCount > 1 {
+		score += w.Count * math.Min(1, math.Log(float64(count))/math.Log(float64(cfg.SaturationCount)))
+	}
+	if _, last := eventSeen(ev); !last.IsZero() && cfg.RecencyWindow > 0 {
+		age := max(now.Sub(last), 0)
+		score += w.Recency * math.Max(0, 1-float64(age)/float64(cfg.RecencyWindow))
+	}
+	return int64(math.Round(100 * score / total))
+}
+
+// eventCount returns the number of occurrences of the event, from its series
+// for the events reported through the events.k8s.io API, at least 1.
+func eventCount(ev *corev1.Event) int32 {
+	count := ev.Count
+	if count == 0 && ev.Series != nil {
+		count = ev.Series.Count
+	}
+	return max(count, 1)
+}
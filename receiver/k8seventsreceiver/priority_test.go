@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventPriority(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	cfg := createDefaultConfig().(*Config).Priority
+
+	tests := []struct {
+		name     string
+		cfg      func(*PriorityConfig)
+		reason   string
+		typ      string
+		count    int32
+		age      time.Duration
+		expected int64
+	}{
+		{
+			name:     "normal_old_single",
+			reason:   "Scheduled",
+			typ:      "Normal",
+			count:    1,
+			age:      2 * time.Hour,
+			expected: 0,
+		},
+		{
+			name:     "warning_only",
+			reason:   "Custom",
+			typ:      "Warning",
+			count:    1,
+			age:      2 * time.Hour,
+			expected: 40,
+		},
+		{
+			name:     "warning_critical_reason",
+			reason:   "BackOff",
+			typ:      "Warning",
+			count:    1,
+			age:      2 * time.Hour,
+			expected: 70,
+		},
+		{
+			name:     "count_at_saturation",
+			reason:   "Scheduled",
+			typ:      "Normal",
+			count:    100,
+			age:      2 * time.Hour,
+			expected: 15,
+		},
+		{
+			name:     "count_above_saturation",
+			reason:   "Scheduled",
+			typ:      "Normal",
+			count:    5000,
+			age:      2 * time.Hour,
+			expected: 15,
+		},
+		{
+			name:   "count_logarithmic",
+			reason: "Scheduled",
+			typ:    "Normal",
+			count:  10,
+			age:    2 * time.Hour,
+			// log(10)/log(100) = 0.5 of the weight of 15.
+			expected: 8,
+		},
+		{
+			name:     "recency_half_window",
+			reason:   "Scheduled",
+			typ:      "Normal",
+			count:    1,
+			age:      30 * time.Minute,
+			expected: 8,
+		},
+		{
+			name:     "maximum",
+			reason:   "OOMKilling",
+			typ:      "Warning",
+			count:    100,
+			age:      0,
+			expected: 100,
+		},
+		{
+			name: "custom_weights",
+			cfg: func(cfg *PriorityConfig) {
+				cfg.Weights = PriorityWeightsConfig{Type: 1, Recency: 3}
+			},
+			reason: "BackOff",
+			typ:    "Warning",
+			count:  100,
+			age:    30 * time.Minute,
+			// (1 + 3 * 0.5) / 4
+			expected: 63,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := cfg
+			if tt.cfg != nil {
+				tt.cfg(&cfg)
+			}
+			ev := getEvent()
+			ev.Reason = tt.reason
+			ev.Type = tt.typ
+			ev.Count = tt.count
+			ev.LastTimestamp = metav1.NewTime(now.Add(-tt.age))
+			assert.Equal(t, tt.expected, cfg.eventPriority(ev, now))
+		})
+	}
+}
+
+func TestK8sEventToLogDataWithPriority(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	ev := getEvent()
+	ev.Type = "Warning"
+	ev.Reason = "Evicted"
+	ev.Count = 1
+	ev.LastTimestamp = metav1.NewTime(time.Now().Add(-3 * time.Hour))
+
+	lr := k8sEventToLogData(zap.NewNop(), ev, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, ok := lr.Attributes().Get(attributePriority)
+	assert.False(t, ok)
+
+	cfg.Priority.Enabled = true
+	lr = k8sEventToLogData(zap.NewNop(), ev, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	priority, ok := lr.Attributes().Get(attributePriority)
+	require.True(t, ok)
+	assert.Equal(t, int64(70), priority.Int())
+}
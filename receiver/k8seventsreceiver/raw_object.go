@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// attributeRawObject is the JSON of the cached involved object of the event.
+const attributeRawObject = "k8s.object.raw"
+
+// rawObjectBuffers pools the buffers the involved objects are marshaled into,
+// since the objects, e.g. the pods, commonly take a few kilobytes.
+var rawObjectBuffers = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// marshalRawObject marshals obj to JSON without its managed fields, which only matter to the
+// server-side apply and commonly take most of the size of the objects. The cached obj is left untouched.
+func marshalRawObject(obj runtime.Object) (string, bool) {
+	if accessor, err := meta.Accessor(obj); err == nil && len(accessor.GetManagedFields()) > 0 {
+		obj = obj.DeepCopyObject()
+		accessor, _ = meta.Accessor(obj)
+		accessor.SetManagedFields(nil)
+	}
+
+	buf := rawObjectBuffers.Get().(*bytes.Buffer)
+	defer func() {
+		buf.Reset()
+		rawObjectBuffers.Put(buf)
+	}()
+	if err := json.NewEncoder(buf).Encode(obj); err != nil {
+		return "", false
+	}
+	// The encoder terminates the JSON with a newline.
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n"))), true
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMarshalRawObject(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "web-1",
+			Namespace:     "test",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-1"},
+	}
+
+	raw, ok := marshalRawObject(pod)
+	require.True(t, ok)
+	var decoded corev1.Pod
+	require.NoError(t, json.Unmarshal([]byte(raw), &decoded))
+	assert.Equal(t, "web-1", decoded.Name)
+	assert.Equal(t, "node-1", decoded.Spec.NodeName)
+	assert.Empty(t, decoded.ManagedFields)
+	assert.NotContains(t, raw, "managedFields")
+	assert.NotContains(t, raw, "\n")
+
+	// The cached object keeps its managed fields.
+	assert.Len(t, pod.ManagedFields, 1)
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"container/list"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// attributeReasonStreak is the number of consecutive events of the same reason about the involved object.
+const attributeReasonStreak = "k8s.event.reason_streak"
+
+// streakTracker counts the consecutive events of the same reason about each object, the streak
+// restarting from 1 on the first event of a different reason. The streaks of at most maxObjects
+// objects are tracked, the least recently observed ones being evicted first.
+type streakTracker struct {
+	maxObjects int
+
+	mu      sync.Mutex
+	streaks map[types.UID]*list.Element
+	order   *list.List
+}
+
+// streak is the current streak of reasons of an object.
+type streak struct {
+	uid    types.UID
+	reason string
+	count  int64
+}
+
+func newStreakTracker(maxObjects int) *streakTracker {
+	return &streakTracker{
+		maxObjects: maxObjects,
+		streaks:    make(map[types.UID]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// observe records an event of reason about the object uid and returns the streak of the reason.
+func (t *streakTracker) observe(uid types.UID, reason string) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	elem, ok := t.streaks[uid]
+	if ok {
+		t.order.MoveToBack(elem)
+	} else {
+		if t.order.Len() >= t.maxObjects {
+			oldest := t.order.Front()
+			t.order.Remove(oldest)
+			delete(t.streaks, oldest.Value.(*streak).uid)
+		}
+		elem = t.order.PushBack(&streak{uid: uid})
+		t.streaks[uid] = elem
+	}
+	s := elem.Value.(*streak)
+	if s.reason == reason && s.count > 0 {
+		s.count++
+	} else {
+		s.reason, s.count = reason, 1
+	}
+	return s.count
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestStreakTracker(t *testing.T) {
+	tracker := newStreakTracker(10)
+
+	assert.Equal(t, int64(1), tracker.observe("pod-a", "BackOff"))
+	assert.Equal(t, int64(2), tracker.observe("pod-a", "BackOff"))
+	assert.Equal(t, int64(3), tracker.observe("pod-a", "BackOff"))
+
+	// Other objects have their own streaks.
+	assert.Equal(t, int64(1), tracker.observe("pod-b", "BackOff"))
+
+	// A different reason restarts the streak, and so does the previous reason recurring.
+	assert.Equal(t, int64(1), tracker.observe("pod-a", "Pulled"))
+	assert.Equal(t, int64(1), tracker.observe("pod-a", "BackOff"))
+	assert.Equal(t, int64(2), tracker.observe("pod-a", "BackOff"))
+	assert.Equal(t, int64(2), tracker.observe("pod-b", "BackOff"))
+}
+
+func TestStreakTrackerEviction(t *testing.T) {
+	tracker := newStreakTracker(2)
+	tracker.observe("pod-a", "BackOff")
+	tracker.observe("pod-b", "BackOff")
+	tracker.observe("pod-a", "BackOff")
+
+	// pod-b is the least recently observed object.
+	assert.Equal(t, int64(1), tracker.observe("pod-c", "BackOff"))
+	assert.Len(t, tracker.streaks, 2)
+	assert.Equal(t, int64(3), tracker.observe("pod-a", "BackOff"))
+	assert.Equal(t, int64(1), tracker.observe("pod-b", "BackOff"))
+}
+
+func TestHandleEventWithReasonStreak(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ReasonStreak.Enabled = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	streaks := func(reasons ...string) []int64 {
+		sink.Reset()
+		for _, reason := range reasons {
+			k8sEvent := getEvent()
+			k8sEvent.Reason = reason
+			recv.handleEvent(k8sEvent)
+		}
+		var got []int64
+		for _, ld := range sink.AllLogs() {
+			streak, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeReasonStreak)
+			require.True(t, ok)
+			got = append(got, streak.Int())
+		}
+		return got
+	}
+	assert.Equal(t, []int64{1, 2, 1, 1, 2, 3}, streaks("BackOff", "BackOff", "Pulled", "BackOff", "BackOff", "BackOff"))
+
+	// The events without an involved object UID have no streak.
+	sink.Reset()
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.UID = types.UID("")
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	_, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeReasonStreak)
+	assert.False(t, ok)
+}
@@ -5,14 +5,41 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
@@ -28,6 +55,63 @@ type k8seventsReceiver struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	obsrecv         *receiverhelper.ObsReport
+	telemetry       *metadata.TelemetryBuilder
+	stats           eventStats
+
+	// Number of namespaces whose events are watched, the cluster wide watch counting as one.
+	watches atomic.Int64
+
+	// Debouncer of the updates of the events, nil unless the updates are debounced.
+	debouncer *debouncer
+
+	// Cache of the involved objects, nil unless the enrichment is enabled.
+	objectCache *objectCache
+	// Cache of the owners chains of the involved objects, nil unless enabled.
+	owners *ownerChainCache
+
+	// Schedule of the times the events are emitted at, nil unless configured.
+	schedule *activeSchedule
+	// Compiled MessageRedactionPatterns, kept per receiver since the configuration may be shared.
+	messageRedactions []*regexp.Regexp
+
+	// Counts of the events seen, nil unless the events are filtered by the growth of their count.
+	countGrowth *countGrowthTracker
+	// Occurrences of the reasons seen, nil unless only the first occurrences are emitted.
+	occurrences *occurrenceSet
+
+	// Logger of the events lost since they failed to be consumed, nil unless enabled.
+	deadLetter *deadLetterLogger
+	shedder    *loadShedder
+	// Throttle of the events, nil unless their rate adapts to the latency of the pipeline.
+	throttle *adaptiveThrottle
+
+	// Tracker of the active Warning events, nil unless the resolved events are emitted.
+	warnings *warningTracker
+
+	// Digest counting the events, nil unless the digests are emitted instead of the events.
+	digest *digest
+	// Aggregator of the identical events across the namespaces, nil unless the aggregations
+	// are emitted instead of the events.
+	aggregator *namespaceAggregator
+
+	// Tracker of the incidents of the involved objects, nil unless the incidents are grouped.
+	incidents *incidentTracker
+
+	// Reasons and involved objects whose events are suppressed, nil unless a ConfigMap is configured.
+	suppressions *suppressionList
+
+	// Attributes of the lookup file, nil unless a lookup file is configured.
+	lookup *lookupTable
+
+	// Tracker of the streaks of reasons of the involved objects, nil unless the streaks are emitted.
+	streaks *streakTracker
+
+	// Namespaces whose events are watched, all of them when it holds only corev1.NamespaceAll.
+	namespaces []string
+
+	// Resource attributes describing the receiver itself,
+	// added to the resource of every emitted event.
+	receiverAttrs pcommon.Map
 }
 
 // newReceiver creates the Kubernetes events receiver with the given configuration.
@@ -43,40 +127,277 @@ func newReceiver(
 		Transport:              transport,
 		ReceiverCreateSettings: set,
 	})
+	if err != nil {
+		set.Logger.Warn("failed to register the receiver telemetry, the events are received without it", zap.Error(err))
+		obsrecv, err = receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+			ReceiverID:             set.ID,
+			Transport:              transport,
+			ReceiverCreateSettings: withoutTelemetry(set),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		set.Logger.Warn("failed to register the receiver telemetry, the events are received without it", zap.Error(err))
+		telemetryBuilder, err = metadata.NewTelemetryBuilder(withoutTelemetry(set).TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	kr := &k8seventsReceiver{
+		settings:      set,
+		config:        config,
+		logsConsumer:  consumer,
+		startTime:     time.Now(),
+		obsrecv:       obsrecv,
+		telemetry:     telemetryBuilder,
+		receiverAttrs: newReceiverAttributes(set, config),
+	}
+	kr.schedule, err = config.ActiveSchedule.compile()
+	if err != nil {
+		return nil, err
+	}
+	kr.messageRedactions, err = compileRedactions(config.MessageRedactionPatterns)
 	if err != nil {
 		return nil, err
 	}
+	if config.SuppressionConfigMap.Name != "" {
+		kr.suppressions = newSuppressionList(set.Logger, config.SuppressionConfigMap.Name)
+	}
+	if config.UpdateDebounce > 0 {
+		kr.debouncer = newDebouncer(config.UpdateDebounce, kr.handleEvent)
+	}
+	if config.MinCountGrowthRate.Enabled {
+		kr.countGrowth = newCountGrowthTracker(config.MinCountGrowthRate.MaxEntries)
+	}
+	if config.FirstOccurrenceOnly.Enabled {
+		kr.occurrences = newOccurrenceSet(config.FirstOccurrenceOnly.MaxEntries)
+	}
+	if config.DeadLetterLog.Enabled {
+		kr.deadLetter = newDeadLetterLogger(set.Logger, config.DeadLetterLog.MaxPerMinute)
+	}
+	if config.LoadSheddingOnFull.Enabled {
+		kr.shedder = newLoadShedder(config.LoadSheddingOnFull.Cooldown)
+	}
+	if config.AdaptiveThrottle.Enabled {
+		kr.throttle = newAdaptiveThrottle(config.AdaptiveThrottle.TargetLatency, config.AdaptiveThrottle.MinRate, config.AdaptiveThrottle.MaxRate)
+	}
+	if config.Enrichment.Enabled && config.Enrichment.OwnerChainCache.Enabled {
+		occ := config.Enrichment.OwnerChainCache
+		kr.owners = newOwnerChainCache(occ.TTL, occ.MaxEntries, occ.MaxConcurrency)
+	}
+	if config.ResolvedEvents.Enabled {
+		kr.warnings = newWarningTracker(config.ResolvedEvents.QuietPeriod, config.ResolvedEvents.MaxEntries)
+	}
+	if config.Digest.Enabled {
+		kr.digest = newDigest(kr.startTime)
+	}
+	kr.stats.intervalStart = kr.startTime
+	if config.CrossNamespaceAggregation.Enabled {
+		kr.aggregator = newNamespaceAggregator(kr.startTime, config.CrossNamespaceAggregation.MaxGroups)
+	}
+	if config.IncidentGrouping.Enabled {
+		kr.incidents = newIncidentTracker(config.IncidentGrouping.Window, config.IncidentGrouping.MaxObjects)
+	}
+	if config.ReasonStreak.Enabled {
+		kr.streaks = newStreakTracker(config.ReasonStreak.MaxObjects)
+	}
+	return kr, nil
+}
 
-	return &k8seventsReceiver{
-		settings:     set,
-		config:       config,
-		logsConsumer: consumer,
-		startTime:    time.Now(),
-		obsrecv:      obsrecv,
-	}, nil
+// withoutTelemetry returns the settings set recording the telemetry nowhere, so that the receiver
+// works in the environments where the telemetry fails to be registered.
+func withoutTelemetry(set receiver.Settings) receiver.Settings {
+	set.MeterProvider = noopmetric.NewMeterProvider()
+	set.TracerProvider = nooptrace.NewTracerProvider()
+	return set
 }
 
-func (kr *k8seventsReceiver) Start(ctx context.Context, _ component.Host) error {
+// newReceiverAttributes builds the resource attributes that are
+// identical for all the events emitted by this receiver.
+func newReceiverAttributes(set receiver.Settings, config *Config) pcommon.Map {
+	attrs := pcommon.NewMap()
+	if config.CollectorNamespace.Enabled {
+		if ns, ok := os.LookupEnv(config.CollectorNamespace.EnvVar); ok && ns != "" {
+			attrs.PutStr(attributeCollectorNamespace, ns)
+		}
+	}
+	if config.EmitCollectorVersion && set.BuildInfo.Version != "" {
+		attrs.PutStr(attributeCollectorVersion, set.BuildInfo.Version)
+	}
+	if config.EmitInstanceID {
+		attrs.PutStr(attributeCollectorInstanceID, uuid.NewString())
+	}
+	if config.CloudProvider.Name != "" {
+		attrs.PutStr(semconv.AttributeCloudProvider, config.CloudProvider.Name)
+	}
+	return attrs
+}
+
+func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) error {
 	kr.ctx, kr.cancel = context.WithCancel(ctx)
 
-	k8sInterface, err := kr.config.getK8sClient()
+	k8sInterface, err := kr.newK8sClient(ctx, host)
 	if err != nil {
 		return err
 	}
 
-	kr.settings.Logger.Info("starting to watch namespaces for the events.")
-	if len(kr.config.Namespaces) == 0 {
-		kr.startWatch(corev1.NamespaceAll, k8sInterface)
-	} else {
-		for _, ns := range kr.config.Namespaces {
-			kr.startWatch(ns, k8sInterface)
+	if kr.config.EmitAPIServerEndpoint {
+		endpoint, err := kr.config.apiServerEndpoint()
+		if err != nil {
+			kr.settings.Logger.Warn("failed to resolve the API server endpoint", zap.Error(err))
+		} else if endpoint != "" {
+			kr.receiverAttrs.PutStr(attributeAPIServerEndpoint, endpoint)
+		}
+	}
+
+	if kr.config.EmitConfigHash {
+		hash, err := kr.config.configHash()
+		if err != nil {
+			kr.settings.Logger.Warn("failed to hash the configuration", zap.Error(err))
+		} else {
+			kr.receiverAttrs.PutStr(attributeConfigHash, hash)
+		}
+	}
+
+	if kr.config.CloudProvider.Name == "" && kr.config.CloudProvider.Detect {
+		provider, err := detectCloudProvider(ctx, k8sInterface)
+		if err != nil {
+			kr.settings.Logger.Warn("failed to detect the cloud provider", zap.Error(err))
+		} else {
+			kr.receiverAttrs.PutStr(semconv.AttributeCloudProvider, provider)
+		}
+	}
+
+	if kr.config.Enrichment.Enabled {
+		kr.objectCache, err = newObjectCache(k8sInterface, kr.config.Enrichment.Kinds)
+		if err != nil {
+			return err
+		}
+		if kr.owners != nil {
+			if err = kr.objectCache.invalidateOwnerChains(kr.owners.invalidate); err != nil {
+				return err
+			}
+		}
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		kr.objectCache.start(stopperChan)
+	}
+
+	if kr.suppressions != nil {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		kr.suppressions.start(k8sInterface, kr.config.SuppressionConfigMap.Namespace, stopperChan)
+	}
+
+	if kr.config.LookupFile.Path != "" {
+		kr.lookup, err = newLookupTable(kr.config.LookupFile.Path)
+		if err != nil {
+			return fmt.Errorf("failed to load the lookup file: %w", err)
+		}
+		if kr.config.LookupFile.ReloadInterval > 0 {
+			stopperChan := make(chan struct{})
+			kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+			go kr.reloadLookupFile(stopperChan)
 		}
 	}
 
+	kr.namespaces, err = kr.resolveNamespaces(ctx, k8sInterface)
+	if err != nil {
+		return err
+	}
+
+	if kr.digest != nil {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		go kr.emitDigests(stopperChan)
+	}
+
+	if kr.config.DropDigest.Enabled {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		go kr.emitDropDigests(stopperChan)
+	}
+
+	if kr.aggregator != nil {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		go kr.emitAggregations(stopperChan)
+	}
+
+	if kr.warnings != nil {
+		stopperChan := make(chan struct{})
+		kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+		go kr.resolveQuietWarnings(stopperChan)
+	}
+
+	delay := kr.startupDelay()
+	if delay > 0 {
+		kr.settings.Logger.Info("delaying the watch of the events", zap.Duration("delay", delay))
+	}
+	kr.settings.Logger.Info("starting to watch namespaces for the events.")
+	for _, ns := range kr.namespaces {
+		kr.startWatch(ns, k8sInterface, delay)
+	}
+
 	return nil
 }
 
-func (kr *k8seventsReceiver) Shutdown(context.Context) error {
+// newK8sClient creates the client of the API server, authenticated by the configured auth extension if any.
+func (kr *k8seventsReceiver) newK8sClient(ctx context.Context, host component.Host) (k8s.Interface, error) {
+	if kr.config.Auth == nil {
+		return kr.config.getK8sClient()
+	}
+	authenticator, err := kr.config.Auth.GetHTTPClientAuthenticator(ctx, host.GetExtensions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the auth extension: %w", err)
+	}
+	return kr.config.getAuthenticatedK8sClient(authenticator)
+}
+
+// watchedNamespaces returns the namespaces to watch, all of them if none is configured.
+func (kr *k8seventsReceiver) watchedNamespaces() []string {
+	if len(kr.config.Namespaces) == 0 {
+		return []string{corev1.NamespaceAll}
+	}
+	return kr.config.Namespaces
+}
+
+// resolveNamespaces returns the namespaces to watch, falling back to the namespaces whose events are
+// accessible if configured, when none is configured and the events of the whole cluster aren't.
+func (kr *k8seventsReceiver) resolveNamespaces(ctx context.Context, client k8s.Interface) ([]string, error) {
+	namespaces := kr.watchedNamespaces()
+	if len(kr.config.Namespaces) > 0 || !kr.config.FallbackToAccessibleNamespaces {
+		return namespaces, nil
+	}
+	accessible, err := accessibleNamespaces(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the accessible namespaces: %w", err)
+	}
+	if accessible == nil {
+		return namespaces, nil
+	}
+	kr.settings.Logger.Info("watching the events of the accessible namespaces only, since watching the whole cluster is forbidden",
+		zap.Strings("namespaces", accessible))
+	return accessible, nil
+}
+
+// setWatchActive records whether the watch of namespace ns is synced and active.
+// The cluster wide watch is recorded with an empty namespace.
+func (kr *k8seventsReceiver) setWatchActive(ns string, active bool) {
+	var value int64
+	if active {
+		value = 1
+	}
+	kr.telemetry.K8seventsWatchActive.Record(context.Background(), value,
+		metric.WithAttributes(attribute.String("namespace", ns)))
+}
+
+func (kr *k8seventsReceiver) Shutdown(ctx context.Context) error {
 	if kr.cancel == nil {
 		return nil
 	}
@@ -84,67 +405,1667 @@ func (kr *k8seventsReceiver) Shutdown(context.Context) error {
 	for _, stopperChan := range kr.stopperChanList {
 		close(stopperChan)
 	}
+	for _, ns := range kr.namespaces {
+		kr.setWatchActive(ns, false)
+	}
+	kr.watches.Store(0)
+	kr.telemetry.K8seventsWatchedNamespaces.Record(context.Background(), 0)
+	// Emit the latest state of the debounced events, before the summary counting them.
+	if kr.debouncer != nil {
+		kr.debouncer.flush()
+	}
+	// Emit the events counted since the last digest.
+	if kr.digest != nil {
+		kr.emitDigest(ctx)
+	}
+	// Emit the events aggregated since the last window.
+	if kr.aggregator != nil {
+		kr.emitAggregation(ctx)
+	}
+	// Emit the events dropped since the last drop digest.
+	if kr.config.DropDigest.Enabled {
+		kr.emitDropDigest(ctx)
+	}
+	// The summary is emitted before the pipeline is shut down,
+	// since the receivers are shut down before the downstream components.
+	if kr.config.EmitShutdownSummary {
+		kr.emitShutdownSummary(ctx)
+	}
 	kr.cancel()
+	kr.telemetry.Shutdown()
 	return nil
 }
 
+// emitDigests emits the digest of the events at every interval until stopperChan is closed.
+func (kr *k8seventsReceiver) emitDigests(stopperChan chan struct{}) {
+	ticker := time.NewTicker(kr.config.Digest.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.emitDigest(kr.ctx)
+		case <-stopperChan:
+			return
+		}
+	}
+}
+
+// emitDigest emits the digest of the events counted since the previous one, if any.
+func (kr *k8seventsReceiver) emitDigest(ctx context.Context) {
+	ld, ok := kr.digest.flush(time.Now())
+	if !ok {
+		return
+	}
+	kr.setScope(ld)
+	kr.addReceiverAttributes(ld)
+	if err := kr.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		kr.settings.Logger.Warn("failed to emit the digest of the events", zap.Error(err))
+	}
+}
+
+// emitDropDigests emits the digest of the dropped events at every interval until stopperChan is closed.
+func (kr *k8seventsReceiver) emitDropDigests(stopperChan chan struct{}) {
+	ticker := time.NewTicker(kr.config.DropDigest.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.emitDropDigest(kr.ctx)
+		case <-stopperChan:
+			return
+		}
+	}
+}
+
+// emitDropDigest emits the digest of the events dropped since the previous one, if any.
+func (kr *k8seventsReceiver) emitDropDigest(ctx context.Context) {
+	ld, ok := kr.stats.dropDigestLogData(time.Now())
+	if !ok {
+		return
+	}
+	kr.setScope(ld)
+	kr.addReceiverAttributes(ld)
+	if err := kr.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		kr.settings.Logger.Warn("failed to emit the digest of the dropped events", zap.Error(err))
+	}
+}
+
+// emitAggregations emits the aggregations of the events at the end of every window until stopperChan is closed.
+func (kr *k8seventsReceiver) emitAggregations(stopperChan chan struct{}) {
+	ticker := time.NewTicker(kr.config.CrossNamespaceAggregation.Window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.emitAggregation(kr.ctx)
+		case <-stopperChan:
+			return
+		}
+	}
+}
+
+// emitAggregation emits the aggregations of the events of the window ending now, if any.
+func (kr *k8seventsReceiver) emitAggregation(ctx context.Context) {
+	ld, ok := kr.aggregator.flush(time.Now())
+	if !ok {
+		return
+	}
+	kr.setScope(ld)
+	kr.addReceiverAttributes(ld)
+	if err := kr.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		kr.settings.Logger.Warn("failed to emit the aggregation of the events", zap.Error(err))
+	}
+}
+
+// emitShutdownSummary emits a log summarizing the events handled during the lifetime of the receiver.
+func (kr *k8seventsReceiver) emitShutdownSummary(ctx context.Context) {
+	ld := kr.stats.summaryLogData(kr.startTime, time.Now())
+	kr.setScope(ld)
+	kr.addReceiverAttributes(ld)
+	if err := kr.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		kr.settings.Logger.Warn("failed to emit the shutdown summary", zap.Error(err))
+	}
+}
+
+// resolveQuietWarnings resolves the Warning events that stopped recurring until stopperChan is closed.
+// They are checked every minute, or more often for the quiet periods shorter than a minute.
+func (kr *k8seventsReceiver) resolveQuietWarnings(stopperChan chan struct{}) {
+	ticker := time.NewTicker(min(kr.config.ResolvedEvents.QuietPeriod, time.Minute))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.emitResolved(kr.ctx, kr.warnings.expire(time.Now()), resolvedByQuietPeriod)
+		case <-stopperChan:
+			return
+		}
+	}
+}
+
+// reloadLookupFile reloads the lookup file once modified, at each reload interval, until stopperChan is closed.
+// The previous attributes are kept when the file fails to load.
+func (kr *k8seventsReceiver) reloadLookupFile(stopperChan chan struct{}) {
+	ticker := time.NewTicker(kr.config.LookupFile.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := kr.lookup.reload()
+			if err != nil {
+				kr.settings.Logger.Warn("failed to reload the lookup file", zap.Error(err))
+			} else if reloaded {
+				kr.settings.Logger.Info("reloaded the lookup file", zap.String("path", kr.config.LookupFile.Path))
+			}
+		case <-stopperChan:
+			return
+		}
+	}
+}
+
+// emitResolved emits a resolved log for each of the Warning events warnings, resolved by resolvedBy.
+// The logs describe the Warning events they resolve as Normal events, at the time they are resolved,
+// and are enriched and finished as the logs of the events.
+func (kr *k8seventsReceiver) emitResolved(ctx context.Context, warnings []*corev1.Event, resolvedBy string) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, warning := range warnings {
+		ev := warning.DeepCopy()
+		ev.Type = corev1.EventTypeNormal
+		ld := k8sEventToLogData(kr.settings.Logger, ev, kr.config, kr.messageRedactions)
+		kr.enrich(ld, ev)
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			sls := rls.At(i).ScopeLogs()
+			for j := 0; j < sls.Len(); j++ {
+				lrs := sls.At(j).LogRecords()
+				for k := 0; k < lrs.Len(); k++ {
+					lr := lrs.At(k)
+					lr.SetTimestamp(now)
+					lr.Attributes().PutBool(attributeResolved, true)
+					lr.Attributes().PutStr(attributeResolvedBy, resolvedBy)
+				}
+			}
+		}
+		kr.finish(ld, ev)
+		if err := kr.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+			kr.settings.Logger.Warn("failed to emit the resolved event", zap.Error(err))
+		}
+	}
+}
+
 // Add the 'Event' handler and trigger the watch for a specific namespace.
 // For new and updated events, the code is relying on the following k8s code implementation:
 // https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/client-go/tools/record/events_cache.go#L327
-func (kr *k8seventsReceiver) startWatch(ns string, client k8s.Interface) {
+// The watch is established after delay, unless the receiver is shut down in the meantime.
+func (kr *k8seventsReceiver) startWatch(ns string, client k8s.Interface, delay time.Duration) {
 	stopperChan := make(chan struct{})
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+	if delay <= 0 {
+		kr.watchNamespace(ns, client, stopperChan)
+		return
+	}
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			kr.watchNamespace(ns, client, stopperChan)
+		case <-stopperChan:
+		}
+	}()
+}
+
+func (kr *k8seventsReceiver) watchNamespace(ns string, client k8s.Interface, stopperChan chan struct{}) {
+	kr.telemetry.K8seventsWatchedNamespaces.Record(context.Background(), kr.watches.Add(1))
 	kr.startWatchingNamespace(client, cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
-			ev := obj.(*corev1.Event)
-			kr.handleEvent(ev)
+			received := time.Now()
+			if ev, ok := kr.eventFromObject(obj); ok {
+				kr.handleReceivedEvent(ev, received)
+			}
 		},
-		UpdateFunc: func(_, obj any) {
-			ev := obj.(*corev1.Event)
-			kr.handleEvent(ev)
+		UpdateFunc: func(oldObj, obj any) {
+			received := time.Now()
+			if isResync(oldObj, obj) {
+				return
+			}
+			ev, ok := kr.eventFromObject(obj)
+			if !ok {
+				return
+			}
+			if kr.debouncer != nil {
+				kr.debouncer.update(ev)
+				return
+			}
+			kr.handleReceivedEvent(ev, received)
 		},
 	}, ns, stopperChan)
 }
 
+// isResync reports whether the update from oldObj to newObj is a resync of the informer,
+// redelivering the cached event unchanged, rather than an update of the event.
+func isResync(oldObj, newObj any) bool {
+	oldAccessor, err := meta.Accessor(oldObj)
+	if err != nil {
+		return false
+	}
+	newAccessor, err := meta.Accessor(newObj)
+	if err != nil {
+		return false
+	}
+	return oldAccessor.GetResourceVersion() != "" && oldAccessor.GetResourceVersion() == newAccessor.GetResourceVersion()
+}
+
+// startupDelay returns a random delay up to the configured startup jitter, spreading
+// the initial listing of the events when many collectors start simultaneously.
+func (kr *k8seventsReceiver) startupDelay() time.Duration {
+	if kr.config.StartupJitter <= 0 {
+		return 0
+	}
+	return rand.N(kr.config.StartupJitter + 1)
+}
+
+// unwrapTombstone returns the object delivered by an informer. Objects removed while the watch
+// was disconnected are delivered wrapped in a cache.DeletedFinalStateUnknown tombstone, which is
+// unwrapped here.
+func unwrapTombstone(obj any) any {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return tombstone.Obj
+	}
+	return obj
+}
+
+// eventFromObject extracts the event from an object delivered by the informer,
+// unwrapping the tombstones. The events of the `events.k8s.io/v1` API are
+// converted to the `v1` API. Objects of any other type are skipped.
+func (kr *k8seventsReceiver) eventFromObject(obj any) (*corev1.Event, bool) {
+	switch ev := unwrapTombstone(obj).(type) {
+	case *corev1.Event:
+		return ev, true
+	case *eventsv1.Event:
+		return eventFromEventsV1(ev), true
+	default:
+		kr.settings.Logger.Debug("skipping object of unexpected type", zap.String("type", fmt.Sprintf("%T", obj)))
+		return nil, false
+	}
+}
+
+// handleEvent handles the event as delivered just now, e.g. once its debounce window expires.
 func (kr *k8seventsReceiver) handleEvent(ev *corev1.Event) {
-	if kr.allowEvent(ev) {
-		ld := k8sEventToLogData(kr.settings.Logger, ev)
+	kr.handleReceivedEvent(ev, time.Now())
+}
+
+// handleReceivedEvent handles the event delivered by the informer at received.
+func (kr *k8seventsReceiver) handleReceivedEvent(ev *corev1.Event, received time.Time) {
+	matched, reason, ok := kr.allowEvent(ev)
+	if !ok {
+		kr.stats.recordDropped(reason)
+		return
+	}
+
+	inMaintenance := kr.config.Maintenance.contains(getEventTimestamp(ev))
+	if inMaintenance && kr.config.Maintenance.Action == maintenanceActionDrop {
+		kr.stats.recordDropped(dropReasonMaintenance)
+		return
+	}
+
+	if kr.schedule != nil && !kr.schedule.contains(getEventTimestamp(ev)) {
+		kr.stats.recordDropped(dropReasonActiveSchedule)
+		return
+	}
+
+	if !kr.allowCountGrowth(ev) {
+		kr.stats.recordDropped(dropReasonCountGrowthRate)
+		return
+	}
+
+	if !kr.allowFirstOccurrence(ev) {
+		kr.stats.recordDropped(dropReasonFirstOccurrence)
+		return
+	}
+
+	if kr.digest != nil {
+		kr.digest.add(ev)
+		kr.stats.recordProcessed()
+		return
+	}
+
+	if kr.aggregator != nil {
+		message := redactMessage(kr.messageRedactions, kr.config.NormalizeMessage.apply(ev.Message))
+		if kr.aggregator.add(ev, message) {
+			kr.stats.recordProcessed()
+			return
+		}
+	}
+
+	if kr.shedder != nil && kr.shedder.shedding(time.Now()) {
+		kr.stats.recordDropped(dropReasonLoadShedding)
+		kr.telemetry.K8seventsLoadShed.Add(context.Background(), 1)
+		return
+	}
+
+	if kr.throttle != nil && !kr.throttle.allow(time.Now()) {
+		kr.stats.recordDropped(dropReasonAdaptiveThrottle)
+		return
+	}
+
+	ld := k8sEventToLogData(kr.settings.Logger, ev, kr.config, kr.messageRedactions)
+	kr.enrich(ld, ev)
+	if inMaintenance {
+		setLogRecordsBool(ld, attributeMaintenance, true)
+	}
+	if kr.incidents != nil && ev.InvolvedObject.UID != "" {
+		setLogRecordsStr(ld, attributeIncidentID, kr.incidents.assign(ev.InvolvedObject.UID, getEventTimestamp(ev)))
+	}
+	if kr.streaks != nil && ev.InvolvedObject.UID != "" {
+		setLogRecordsInt(ld, attributeReasonStreak, kr.streaks.observe(ev.InvolvedObject.UID, ev.Reason))
+	}
+	if kr.config.EmitWatchScope {
+		if scope := kr.watchScope(); scope != "" {
+			setLogRecordsStr(ld, attributeWatchScope, scope)
+		}
+	}
+	if kr.config.EmitMatchedFilters {
+		setLogRecordsStrings(ld, attributeMatchedFilters, matched)
+	}
+	if kr.config.EmitInternalLatency {
+		// Measured last, so that the time spent waiting for the enrichment is included.
+		setLogRecordsDouble(ld, attributeInternalLatency, float64(time.Since(received))/float64(time.Millisecond))
+	}
+	kr.finish(ld, ev)
 
-		ctx := kr.obsrecv.StartLogsOp(kr.ctx)
-		consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
-		kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 1, consumerErr)
+	ctx := kr.obsrecv.StartLogsOp(kr.ctx)
+	consumeStart := time.Now()
+	consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
+	kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 1, consumerErr)
+	if kr.throttle != nil {
+		now := time.Now()
+		kr.telemetry.K8seventsThrottleRate.Record(context.Background(), kr.throttle.observe(now.Sub(consumeStart), now))
+	}
+	// The receiver doesn't retry, the error returned by the pipeline is final.
+	if kr.shedder != nil && kr.shedder.observe(consumerErr, time.Now()) {
+		kr.settings.Logger.Warn("the pipeline is at capacity, dropping the events during the cooldown",
+			zap.Duration("cooldown", kr.config.LoadSheddingOnFull.Cooldown), zap.Error(consumerErr))
+	}
+	if consumerErr != nil && kr.deadLetter != nil {
+		kr.deadLetter.log(ev, consumerErr)
+		kr.telemetry.K8seventsDeadLettered.Add(context.Background(), 1)
+	}
+	kr.stats.recordProcessed()
+	if kr.warnings != nil {
+		kr.emitResolved(kr.ctx, kr.warnings.observe(ev), resolvedByNormalEvent)
 	}
 }
 
-// startWatchingNamespace creates an informer and starts
-// watching a specific namespace for the events.
-func (kr *k8seventsReceiver) startWatchingNamespace(
-	clientset k8s.Interface,
-	handlers cache.ResourceEventHandlerFuncs,
-	ns string,
-	stopper chan struct{},
-) {
-	client := clientset.CoreV1().RESTClient()
-	watchList := cache.NewListWatchFromClient(client, "events", ns, fields.Everything())
-	_, controller := cache.NewInformerWithOptions(cache.InformerOptions{
-		ListerWatcher: watchList,
-		ObjectType:    &corev1.Event{},
-		ResyncPeriod:  0,
-		Handler:       handlers,
-	})
-	go controller.Run(stopper)
+// enrich sets the scope of ld and adds the attributes of the receiver and of the enrichment
+// about the event ev to ld.
+func (kr *k8seventsReceiver) enrich(ld plog.Logs, ev *corev1.Event) {
+	kr.setScope(ld)
+	kr.addReceiverAttributes(ld)
+	kr.addRoute(ld, ev)
+	kr.addNamespaceAttributes(ld, ev)
+	kr.addLookupAttributes(ld, ev)
+	kr.addNamespaceOwner(ld, ev)
+	if kr.config.StreamNameTemplate != "" {
+		// Built once the resource attributes are set, since the cluster is one of them.
+		putStreamName(ld, kr.config.StreamNameTemplate, ev)
+	}
+	kr.addInvolvedObjectLabels(ld, ev)
+	kr.addInvolvedObjectJSONAnnotations(ld, ev)
+	kr.addObjectNameBase(ld, ev)
+	kr.addRawObject(ld, ev)
+	kr.addObjectAgeAtEvent(ld, ev)
+	kr.addWorkload(ld, ev)
+	kr.addContainerTermination(ld, ev)
+	kr.addProbeStatus(ld, ev)
+	kr.addServiceNetwork(ld, ev)
+	kr.addStorageBinding(ld, ev)
+	kr.addResourceQuota(ld, ev)
+	kr.addNodeName(ld, ev)
+	kr.addReportingZone(ld, ev)
+	kr.addWorkloadGeneration(ld, ev)
+	kr.addObjectHealth(ld, ev)
+	kr.addControllerRevision(ld, ev)
+	kr.addJobStatus(ld, ev)
 }
 
-// Allow events with eventTimestamp(EventTime/LastTimestamp/FirstTimestamp)
-// not older than the receiver start time so that
-// event flood can be avoided upon startup.
-func (kr *k8seventsReceiver) allowEvent(ev *corev1.Event) bool {
-	eventTimestamp := getEventTimestamp(ev)
-	return !eventTimestamp.Before(kr.startTime)
+// finish reshapes, trims and sorts the attributes of ld, once all of them are added.
+func (kr *k8seventsReceiver) finish(ld plog.Logs, ev *corev1.Event) {
+	kr.reshapeAttributes(ld, ev)
+	kr.config.AttributeLimits.trimAttributes(ld)
+	if kr.config.SortAttributes {
+		sortAttributes(ld)
+	}
+}
+
+// reshapeAttributes applies the configured layout to the attributes of ld. It runs once all
+// the attributes are added, so that the attributes added by the receiver are reshaped too.
+func (kr *k8seventsReceiver) reshapeAttributes(ld plog.Logs, ev *corev1.Event) {
+	if kr.config.SourceNamespacedAttributes {
+		if controller := reportingController(ev); controller != "" {
+			prefixLogRecordsAttributes(ld, controller+".")
+		}
+	}
+	if len(kr.config.ResourceGroupBy) > 0 {
+		regroupLogRecordsAttributes(ld, kr.config.ResourceGroupBy)
+	}
+	if kr.config.EventDomainMode {
+		nestLogRecordsEventDomain(ld)
+	}
+}
+
+// setLogRecordsAttributes copies attrs to the attributes of all the log records of ld.
+func setLogRecordsAttributes(ld plog.Logs, attrs pcommon.Map) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrAttrs := lrs.At(k).Attributes()
+				attrs.Range(func(key string, v pcommon.Value) bool {
+					v.CopyTo(lrAttrs.PutEmpty(key))
+					return true
+				})
+			}
+		}
+	}
+}
+
+// setLogRecordsBool sets the boolean attribute key on all the log records of ld.
+func setLogRecordsBool(ld plog.Logs, key string, value bool) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrs.At(k).Attributes().PutBool(key, value)
+			}
+		}
+	}
+}
+
+// setLogRecordsDouble sets the double attribute key on all the log records of ld.
+func setLogRecordsDouble(ld plog.Logs, key string, value float64) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrs.At(k).Attributes().PutDouble(key, value)
+			}
+		}
+	}
+}
+
+// setLogRecordsInt sets the int attribute key on all the log records of ld.
+func setLogRecordsInt(ld plog.Logs, key string, value int64) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrs.At(k).Attributes().PutInt(key, value)
+			}
+		}
+	}
+}
+
+// setLogRecordsStr sets the string attribute key on all the log records of ld.
+func setLogRecordsStr(ld plog.Logs, key, value string) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrs.At(k).Attributes().PutStr(key, value)
+			}
+		}
+	}
+}
+
+// setLogRecordsStrings sets the string slice attribute key on all the log records of ld.
+func setLogRecordsStrings(ld plog.Logs, key string, values []string) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				s := lrs.At(k).Attributes().PutEmptySlice(key)
+				s.EnsureCapacity(len(values))
+				for _, v := range values {
+					s.AppendEmpty().SetStr(v)
+				}
+			}
+		}
+	}
+}
+
+// Scopes of the watches delivering the events.
+const (
+	watchScopeCluster    = "cluster"
+	watchScopeNamespaced = "namespaced"
+)
+
+// watchScope returns the scope of the watches delivering the events, empty until they are started.
+// The events are either watched in the whole cluster or in each of the resolved namespaces,
+// so that all the watches of the receiver share the same scope.
+func (kr *k8seventsReceiver) watchScope() string {
+	switch {
+	case len(kr.namespaces) == 0:
+		return ""
+	case slices.Contains(kr.namespaces, corev1.NamespaceAll):
+		return watchScopeCluster
+	default:
+		return watchScopeNamespaced
+	}
+}
+
+// setScope sets the instrumentation scope of all the logs of ld to the receiver,
+// and their schema URL to the version of the semantic conventions of their attributes,
+// for the backends validating the OTLP payloads strictly.
+func (kr *k8seventsReceiver) setScope(ld plog.Logs) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		rl.SetSchemaUrl(semconv.SchemaURL)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			sl.SetSchemaUrl(semconv.SchemaURL)
+			sl.Scope().SetName(metadata.Type.String())
+			sl.Scope().SetVersion(kr.settings.BuildInfo.Version)
+		}
+	}
+}
+
+// addReceiverAttributes copies the receiver level attributes to all the resources of ld.
+func (kr *k8seventsReceiver) addReceiverAttributes(ld plog.Logs) {
+	if kr.receiverAttrs.Len() == 0 {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		attrs := rls.At(i).Resource().Attributes()
+		kr.receiverAttrs.Range(func(k string, v pcommon.Value) bool {
+			v.CopyTo(attrs.PutEmpty(k))
+			return true
+		})
+	}
+}
+
+// addRoute sets the route of the event, derived from its type, on all the resources of ld.
+func (kr *k8seventsReceiver) addRoute(ld plog.Logs, ev *corev1.Event) {
+	if kr.config.Routing.Attribute == "" {
+		return
+	}
+	route := kr.config.Routing.lookup(ev.Type)
+	if route == "" {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(kr.config.Routing.Attribute, route)
+	}
+}
+
+// lookup returns the route of the events of type typ, the default route if the type has no route.
+func (cfg *RoutingConfig) lookup(typ string) string {
+	for t, route := range cfg.Routes {
+		if strings.EqualFold(t, typ) {
+			return route
+		}
+	}
+	return cfg.Default
+}
+
+// addNamespaceAttributes adds the static attributes configured for the namespace
+// of the event to all the resources of ld, replacing any attribute with the same key.
+func (kr *k8seventsReceiver) addNamespaceAttributes(ld plog.Logs, ev *corev1.Event) {
+	nsAttrs, ok := kr.config.NamespaceResourceAttributes[ev.Namespace]
+	if !ok {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		attrs := rls.At(i).Resource().Attributes()
+		for k, v := range nsAttrs {
+			attrs.PutStr(k, v)
+		}
+	}
+}
+
+// addLookupAttributes adds the attributes of the lookup file matching the event to all the resources of ld.
+func (kr *k8seventsReceiver) addLookupAttributes(ld plog.Logs, ev *corev1.Event) {
+	if kr.lookup == nil {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		kr.lookup.putAttributes(rls.At(i).Resource().Attributes(), ev)
+	}
+}
+
+// startWatchingNamespace creates an informer and starts
+// watching a specific namespace for the events.
+func (kr *k8seventsReceiver) startWatchingNamespace(
+	clientset k8s.Interface,
+	handlers cache.ResourceEventHandlerFuncs,
+	ns string,
+	stopper chan struct{},
+) {
+	watchList, objectType := kr.eventsListWatch(clientset, ns)
+
+	if kr.config.DeduplicateRelists {
+		kr.deduplicateRelists(watchList, &handlers)
+	}
+
+	// Track the watch status: the watch is active once the informer has synced,
+	// inactive whenever listing or watching fails and active again once it relists.
+	var controller cache.Controller
+	list, watchFunc := watchList.ListFunc, watchList.WatchFunc
+	watchList.ListFunc = func(options metav1.ListOptions) (runtime.Object, error) {
+		obj, err := list(options)
+		if err != nil {
+			kr.setWatchActive(ns, false)
+		} else if controller.HasSynced() {
+			kr.setWatchActive(ns, true)
+		}
+		return obj, err
+	}
+	watchList.WatchFunc = func(options metav1.ListOptions) (watch.Interface, error) {
+		w, err := watchFunc(options)
+		if err != nil {
+			kr.setWatchActive(ns, false)
+		}
+		return w, err
+	}
+
+	_, controller = cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: watchList,
+		ObjectType:    objectType,
+		ResyncPeriod:  kr.config.ResyncPeriod,
+		Handler:       handlers,
+	})
+	go controller.Run(stopper)
+	go func() {
+		if cache.WaitForCacheSync(stopper, controller.HasSynced) {
+			kr.setWatchActive(ns, true)
+		}
+	}()
+}
+
+// eventsListWatch returns the list and watch of the events of ns from the configured API, along with
+// the type of the events delivered.
+func (kr *k8seventsReceiver) eventsListWatch(clientset k8s.Interface, ns string) (*cache.ListWatch, runtime.Object) {
+	if kr.config.WatchAPI == watchAPIEvents {
+		client := clientset.EventsV1().Events(ns)
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				kr.paginate(&options)
+				options.FieldSelector = kr.fieldSelector()
+				return client.List(kr.ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.FieldSelector = kr.fieldSelector()
+				return client.Watch(kr.ctx, options)
+			},
+		}, &eventsv1.Event{}
+	}
+	client := clientset.CoreV1().Events(ns)
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			kr.paginate(&options)
+			options.FieldSelector = kr.fieldSelector()
+			return client.List(kr.ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = kr.fieldSelector()
+			return client.Watch(kr.ctx, options)
+		},
+	}, &corev1.Event{}
+}
+
+// deduplicateRelists drops the events redelivered by the lists of watchList, except the first one,
+// before they reach handlers. The following pages of the paginated lists belong to the same list.
+func (kr *k8seventsReceiver) deduplicateRelists(watchList *cache.ListWatch, handlers *cache.ResourceEventHandlerFuncs) {
+	filter := &relistFilter{}
+	list := watchList.ListFunc
+	watchList.ListFunc = func(options metav1.ListOptions) (runtime.Object, error) {
+		if options.Continue == "" {
+			filter.relist()
+		}
+		return list(options)
+	}
+	add, update := handlers.AddFunc, handlers.UpdateFunc
+	handlers.AddFunc = func(obj any) {
+		if filter.redelivered(obj) {
+			kr.stats.recordDropped(dropReasonRelisted)
+			return
+		}
+		add(obj)
+	}
+	handlers.UpdateFunc = func(oldObj, newObj any) {
+		if filter.redelivered(newObj) {
+			kr.stats.recordDropped(dropReasonRelisted)
+			return
+		}
+		update(oldObj, newObj)
+	}
+}
+
+// paginate limits the lists of the events to the configured page size. The informer follows the
+// continue tokens of the paginated lists, falling back to a full list if a token expires.
+// The lists from the watch cache of the API server, at resource version "0", are never paginated,
+// so the first page is read from etcd instead.
+func (kr *k8seventsReceiver) paginate(options *metav1.ListOptions) {
+	if kr.config.ListPageSize <= 0 {
+		return
+	}
+	options.Limit = kr.config.ListPageSize
+	if options.ResourceVersion == "0" {
+		options.ResourceVersion = ""
+	}
+}
+
+// fieldSelector returns the field selector of the listed and watched events, selecting the type of
+// the events when a single one is emitted, so that the API server filters out the other events.
+// Several types can't be selected by a single field selector, so they are filtered in allowEvent.
+func (kr *k8seventsReceiver) fieldSelector() string {
+	if len(kr.config.EventTypes) != 1 {
+		return ""
+	}
+	return fields.OneTermEqualSelector("type", kr.config.EventTypes[0]).String()
+}
+
+// Allow events with eventTimestamp(EventTime/LastTimestamp/FirstTimestamp)
+// not older than the receiver start time so that
+// event flood can be avoided upon startup.
+// Events without an involved object are dropped if required by the configuration,
+// as well as the events of the collector itself and the suppressed ones.
+// The reason the event is dropped for is returned along. Each check runs once,
+// since some of them, such as the lookups of the enrichment, have side effects.
+// When the matched filters are emitted, the names of the configured filters which
+// evaluated and passed the event are collected along the checks.
+func (kr *k8seventsReceiver) allowEvent(ev *corev1.Event) (matched []string, reason string, ok bool) {
+	passed := func(filter string) {
+		if kr.config.EmitMatchedFilters {
+			matched = append(matched, filter)
+		}
+	}
+	// The events are filtered by namespace and deduplicated by the watches delivering them.
+	if kr.watchScope() == watchScopeNamespaced && slices.Contains(kr.namespaces, ev.Namespace) {
+		passed("namespaces")
+	}
+	if kr.config.DeduplicateRelists {
+		passed("deduplicate_relists")
+	}
+	if kr.isSelfEvent(ev) {
+		return nil, dropReasonSelfEvent, false
+	}
+	if kr.config.SuppressSelfEvents != "" {
+		passed("suppress_self_events")
+	}
+	if kr.suppressed(ev) {
+		return nil, dropReasonSuppressionConfigMap, false
+	}
+	if kr.suppressions != nil {
+		passed("suppression_config_map")
+	}
+	if kr.config.RequireInvolvedObject {
+		if ev.InvolvedObject == (corev1.ObjectReference{}) {
+			return nil, dropReasonNoInvolvedObject, false
+		}
+		passed("require_involved_object")
+	}
+	if kr.excludedName(ev) {
+		return nil, dropReasonExcludedName, false
+	}
+	if len(kr.config.ExcludeInvolvedObjectNames) > 0 {
+		passed("exclude_involved_object_names")
+	}
+	if !kr.allowType(ev) {
+		return nil, dropReasonEventType, false
+	}
+	if len(kr.config.EventTypes) > 0 {
+		passed("event_types")
+	}
+	if !kr.sampled(ev) {
+		return nil, dropReasonSampled, false
+	}
+	if kr.config.ConsistentSampleRate < 1 && ev.InvolvedObject.UID != "" {
+		passed("consistent_sample_rate")
+	}
+	if getEventTimestamp(ev).Before(kr.startTime) {
+		return nil, dropReasonBeforeStart, false
+	}
+	passed("start_time")
+	if !kr.allowEnrichment(ev) {
+		return nil, dropReasonEnrichment, false
+	}
+	// The filters relying on the enrichment only evaluate the events about cached objects.
+	cached := false
+	if kr.config.EmitMatchedFilters {
+		_, cached = kr.involvedObject(ev)
+	}
+	if !kr.allowInvolvedObjectAge(ev) {
+		return nil, dropReasonMinInvolvedObjectAge, false
+	}
+	if kr.config.MinInvolvedObjectAge > 0 && cached {
+		passed("min_involved_object_age")
+	}
+	if !kr.allowInvolvedObjectAnnotations(ev) {
+		return nil, dropReasonInvolvedObjectAnnotations, false
+	}
+	if len(kr.config.InvolvedObjectAnnotationSelector.MatchAnnotations) > 0 && cached {
+		passed("involved_object_annotation_selector")
+	}
+	if !kr.allowWorkload(ev) {
+		return nil, dropReasonWorkloadSelector, false
+	}
+	if len(kr.config.WorkloadSelector.Deployments) > 0 {
+		passed("workload_selector")
+	}
+	return matched, "", true
+}
+
+// isSelfEvent reports whether the event is reported by the collector itself.
+func (kr *k8seventsReceiver) isSelfEvent(ev *corev1.Event) bool {
+	return kr.config.SuppressSelfEvents != "" && reportingController(ev) == kr.config.SuppressSelfEvents
+}
+
+// suppressed reports whether the events of the reason or the involved object of ev are
+// currently suppressed by the suppression ConfigMap.
+func (kr *k8seventsReceiver) suppressed(ev *corev1.Event) bool {
+	return kr.suppressions != nil && kr.suppressions.suppresses(ev)
+}
+
+// allowType reports whether the type of the event is emitted. The events selected by the field selector
+// of the watch are checked again, for the API servers ignoring the field selectors.
+func (kr *k8seventsReceiver) allowType(ev *corev1.Event) bool {
+	return len(kr.config.EventTypes) == 0 || slices.Contains(kr.config.EventTypes, ev.Type)
+}
+
+// excludedName reports whether the name of the involved object of the event matches an excluded pattern.
+func (kr *k8seventsReceiver) excludedName(ev *corev1.Event) bool {
+	for _, pattern := range kr.config.ExcludeInvolvedObjectNames {
+		// The patterns are validated.
+		if matched, _ := path.Match(pattern, ev.InvolvedObject.Name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// sampled reports whether the involved object of the event is sampled, by comparing
+// the hash of its UID with the consistent sample rate. The events without an involved
+// object UID are always sampled.
+func (kr *k8seventsReceiver) sampled(ev *corev1.Event) bool {
+	if kr.config.ConsistentSampleRate >= 1 || ev.InvolvedObject.UID == "" {
+		return true
+	}
+	// The high bits of FNV hashes hardly vary between the UIDs differing in their last bytes,
+	// which would skew the sampling, so a cryptographic hash is used instead.
+	sum := sha256.Sum256([]byte(ev.InvolvedObject.UID))
+	return float64(binary.BigEndian.Uint64(sum[:8])) < kr.config.ConsistentSampleRate*math.MaxUint64
+}
+
+// allowEnrichment looks up the involved object of the event in the cache, waiting up to
+// the enrichment timeout for the cache to be synced, so that the enrichment never stalls
+// the pipeline. Misses are recorded, and the events are dropped if required by the fallback.
+// Events about objects of kinds which are not cached are always allowed.
+func (kr *k8seventsReceiver) allowEnrichment(ev *corev1.Event) bool {
+	if kr.objectCache == nil || !kr.objectCache.caches(ev.InvolvedObject.Kind) {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(kr.ctx, kr.config.Enrichment.Timeout)
+	defer cancel()
+	var reason string
+	if !kr.objectCache.waitForSync(ctx, ev.InvolvedObject.Kind) {
+		reason = "not_synced"
+	} else if _, ok := kr.objectCache.get(&ev.InvolvedObject); !ok {
+		reason = "not_found"
+	} else {
+		return true
+	}
+	kr.telemetry.K8seventsEnrichmentMisses.Add(context.Background(), 1,
+		metric.WithAttributes(attribute.String("reason", reason)))
+	return kr.config.Enrichment.Fallback != enrichmentFallbackDrop
+}
+
+// allowCountGrowth allows only the events whose count grows faster than the configured rate since their
+// previous observation. The events without a UID can't be tracked, their growth is the one of their count
+// since their first occurrence.
+func (kr *k8seventsReceiver) allowCountGrowth(ev *corev1.Event) bool {
+	if kr.countGrowth == nil {
+		return true
+	}
+	first, last := eventSeen(ev)
+	if ev.UID == "" {
+		return countGrowthSinceFirst(eventCount(ev), first, last) >= kr.config.MinCountGrowthRate.PerMinute
+	}
+	return kr.countGrowth.observe(ev.UID, eventCount(ev), first, last) >= kr.config.MinCountGrowthRate.PerMinute
+}
+
+// allowFirstOccurrence allows only the first event of each reason for each involved object,
+// counting the suppressed repeats. Events without an involved object UID are always allowed.
+// The check comes last, so that the occurrences dropped by the other filters aren't remembered.
+func (kr *k8seventsReceiver) allowFirstOccurrence(ev *corev1.Event) bool {
+	if kr.occurrences == nil || ev.InvolvedObject.UID == "" {
+		return true
+	}
+	if kr.occurrences.add(occurrence{uid: ev.InvolvedObject.UID, reason: ev.Reason}) {
+		return true
+	}
+	kr.telemetry.K8seventsRepeatsSuppressed.Add(context.Background(), 1)
+	return false
+}
+
+// involvedObject returns the cached object the event is about.
+func (kr *k8seventsReceiver) involvedObject(ev *corev1.Event) (runtime.Object, bool) {
+	if kr.objectCache == nil {
+		return nil, false
+	}
+	return kr.objectCache.get(&ev.InvolvedObject)
+}
+
+// addNamespaceOwner adds the owner of the event's namespace to all the resources of ld.
+// The attribute is omitted when the namespace isn't cached or lacks the annotation.
+func (kr *k8seventsReceiver) addNamespaceOwner(ld plog.Logs, ev *corev1.Event) {
+	if kr.config.NamespaceOwnerAnnotation == "" || kr.objectCache == nil {
+		return
+	}
+	ns := ev.InvolvedObject.Namespace
+	if ns == "" {
+		ns = ev.Namespace
+	}
+	if ns == "" {
+		return
+	}
+	obj, ok := kr.objectCache.get(&corev1.ObjectReference{Kind: "Namespace", Name: ns})
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	owner, ok := accessor.GetAnnotations()[kr.config.NamespaceOwnerAnnotation]
+	if !ok || owner == "" {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(attributeNamespaceOwner, owner)
+	}
+}
+
+// addInvolvedObjectLabels adds the configured labels of the cached involved object of the event
+// to all the resources of ld, e.g. `k8s.pod.label.app`. The labels the object lacks are omitted.
+func (kr *k8seventsReceiver) addInvolvedObjectLabels(ld plog.Logs, ev *corev1.Event) {
+	if len(kr.config.InvolvedObjectLabelKeys) == 0 {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	labels := accessor.GetLabels()
+	prefix := "k8s." + strings.ToLower(ev.InvolvedObject.Kind) + ".label."
+	rls := ld.ResourceLogs()
+	for _, key := range kr.config.InvolvedObjectLabelKeys {
+		value, ok := labels[key]
+		if !ok {
+			continue
+		}
+		for i := 0; i < rls.Len(); i++ {
+			rls.At(i).Resource().Attributes().PutStr(prefix+key, value)
+		}
+	}
+}
+
+// addObjectNameBase adds the name of the workload the involved object of the event belongs to
+// to the log records of ld. The controllers of the cached pods and ReplicaSets are followed,
+// e.g. up to the Deployment of a pod, and the generated suffixes of the others are stripped.
+func (kr *k8seventsReceiver) addObjectNameBase(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.StripGeneratedSuffix || ev.InvolvedObject.Name == "" {
+		return
+	}
+	ref := ev.InvolvedObject
+	for _, owner := range kr.ownerChain(&ev.InvolvedObject) {
+		if (ref.Kind != "Pod" && ref.Kind != "ReplicaSet") || !workloadOwnerKinds[owner.Kind] {
+			break
+		}
+		ref = owner
+	}
+	setLogRecordsStr(ld, attributeObjectNameBase, baseObjectName(ref.Kind, ref.Name))
+}
+
+// ownerChain returns the controllers of the cached object referenced by ref, from its own controller up,
+// resolved through the cache of the owners chains if enabled.
+func (kr *k8seventsReceiver) ownerChain(ref *corev1.ObjectReference) []corev1.ObjectReference {
+	if kr.objectCache == nil {
+		return nil
+	}
+	if kr.owners == nil || ref.UID == "" {
+		chain, _ := kr.objectCache.ownerChain(ref)
+		return chain
+	}
+	chain, hit := kr.owners.resolve(ref.UID, time.Now(), func() ([]corev1.ObjectReference, bool) {
+		return kr.objectCache.ownerChain(ref)
+	})
+	if hit {
+		kr.telemetry.K8seventsOwnerCacheHits.Add(context.Background(), 1)
+	} else {
+		kr.telemetry.K8seventsOwnerCacheMisses.Add(context.Background(), 1)
+	}
+	return chain
+}
+
+// addRawObject adds the JSON of the cached involved object of the event to the log records of ld.
+// The attribute is omitted when the object isn't cached.
+func (kr *k8seventsReceiver) addRawObject(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitRawObject {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	raw, ok := marshalRawObject(obj)
+	if !ok {
+		return
+	}
+	setLogRecordsStr(ld, attributeRawObject, raw)
+}
+
+// addObjectAgeAtEvent adds the age of the cached involved object at the time of the event to the log
+// records of ld, telling the events about the brand-new objects from the ones about the long-lived objects.
+// The attribute is omitted when the object isn't cached.
+func (kr *k8seventsReceiver) addObjectAgeAtEvent(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitObjectAgeAtEvent {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	created := accessor.GetCreationTimestamp()
+	if created.IsZero() {
+		return
+	}
+	age := getEventTimestamp(ev).Sub(created.Time)
+	setLogRecordsDouble(ld, attributeObjectAgeAtEvent, age.Seconds())
+}
+
+// probeReasons are the reasons of the events reporting the probes of the containers.
+var probeReasons = map[string]bool{
+	"Unhealthy":    true,
+	"ProbeWarning": true,
+}
+
+// probeTypeRegexp matches the type of the probe at the start of the messages of the probe events,
+// e.g. "Readiness probe failed: HTTP probe failed with statuscode: 503".
+var probeTypeRegexp = regexp.MustCompile(`^(Liveness|Readiness|Startup) probe`)
+
+// addProbeStatus adds the type of the probe and whether the cached pod is ready
+// to the log records of ld, if the event is a probe event of a pod.
+func (kr *k8seventsReceiver) addProbeStatus(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitProbeStatus || !probeReasons[ev.Reason] || ev.InvolvedObject.Kind != "Pod" {
+		return
+	}
+	if m := probeTypeRegexp.FindStringSubmatch(ev.Message); m != nil {
+		setLogRecordsStr(ld, attributeProbeType, strings.ToLower(m[1]))
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			setLogRecordsBool(ld, attributePodReady, cond.Status == corev1.ConditionTrue)
+			return
+		}
+	}
+}
+
+// crashReasons are the reasons of the events reporting crashing containers.
+var crashReasons = map[string]bool{
+	"BackOff":          true,
+	"CrashLoopBackOff": true,
+}
+
+// addContainerTermination adds the last termination of the crashing container
+// to the log records of ld, if the event is about a crashing container of a cached pod.
+func (kr *k8seventsReceiver) addContainerTermination(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitContainerTermination || !crashReasons[ev.Reason] || ev.InvolvedObject.Kind != "Pod" {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	terminated := lastTermination(pod, containerFromFieldPath(ev.InvolvedObject.FieldPath))
+	if terminated == nil {
+		return
+	}
+	attrs := pcommon.NewMap()
+	attrs.PutStr(attributeContainerTerminationReason, terminated.Reason)
+	attrs.PutInt(attributeContainerTerminationExitCode, int64(terminated.ExitCode))
+	setLogRecordsAttributes(ld, attrs)
+}
+
+// addNodeName sets the node the involved object maps to as the node name of all the resources of ld,
+// replacing the host reporting the event. The node name is left as is for the other objects,
+// the pods missing from the cache and the pods which aren't scheduled yet.
+func (kr *k8seventsReceiver) addNodeName(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.ResolveNodeName {
+		return
+	}
+	var node string
+	switch ev.InvolvedObject.Kind {
+	case "Node":
+		node = ev.InvolvedObject.Name
+	case "Pod":
+		if obj, ok := kr.involvedObject(ev); ok {
+			if pod, ok := obj.(*corev1.Pod); ok {
+				node = pod.Spec.NodeName
+			}
+		}
+	}
+	if node == "" {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(semconv.AttributeK8SNodeName, node)
+	}
+}
+
+// addReportingZone sets the zone of the node reporting the kubelet event, i.e. its reporting instance
+// or the host of its source, as the availability zone of all the resources of ld. The zone is the
+// topology label of the cached node, falling back to the deprecated failure domain label. The attribute
+// is omitted for the events of the other controllers and the nodes missing from the cache or unzoned.
+func (kr *k8seventsReceiver) addReportingZone(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitReportingZone || kr.objectCache == nil || reportingController(ev) != "kubelet" {
+		return
+	}
+	node := ev.ReportingInstance
+	if node == "" {
+		node = ev.Source.Host
+	}
+	if node == "" {
+		return
+	}
+	obj, ok := kr.objectCache.get(&corev1.ObjectReference{Kind: "Node", Name: node})
+	if !ok {
+		return
+	}
+	n, ok := obj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	zone := n.Labels[corev1.LabelTopologyZone]
+	if zone == "" {
+		zone = n.Labels[corev1.LabelFailureDomainBetaZone]
+	}
+	if zone == "" {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(semconv.AttributeCloudAvailabilityZone, zone)
+	}
+}
+
+// addWorkloadGeneration adds the generation of the cached Deployment or StatefulSet the event is about,
+// and the generation observed by its controller, to the log records of ld. While the controller
+// rolls out a change, the observed generation lags behind. The observed generation is omitted
+// until the controller observes the workload.
+func (kr *k8seventsReceiver) addWorkloadGeneration(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitWorkloadGeneration {
+		return
+	}
+	kind := ev.InvolvedObject.Kind
+	if kind != "Deployment" && kind != "StatefulSet" {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	var generation, observedGeneration int64
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		generation, observedGeneration = o.Generation, o.Status.ObservedGeneration
+	case *appsv1.StatefulSet:
+		generation, observedGeneration = o.Generation, o.Status.ObservedGeneration
+	default:
+		return
+	}
+	attrs := pcommon.NewMap()
+	if generation > 0 {
+		attrs.PutInt(attributeWorkloadGeneration, generation)
+	}
+	if observedGeneration > 0 {
+		attrs.PutInt(attributeWorkloadObservedGeneration, observedGeneration)
+	}
+	setLogRecordsAttributes(ld, attrs)
+}
+
+// addObjectHealth adds the health of the cached object the event is about, derived from its conditions,
+// to the resources of ld. The attribute is omitted when the object isn't cached or has no conditions.
+func (kr *k8seventsReceiver) addObjectHealth(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitObjectHealth {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	health, ok := objectHealth(obj)
+	if !ok {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(attributeObjectHealth, health)
+	}
+}
+
+// scaledReplicaSetRegexp matches the name of the ReplicaSet in the messages of the rollout events
+// of the Deployments, e.g. `Scaled up replica set web-7d9f8c6b5 to 3`.
+var scaledReplicaSetRegexp = regexp.MustCompile(`replica set (\S+)`)
+
+// addControllerRevision adds the revision of the rollout the event belongs to to the log records of ld.
+// The revision of the events about the Deployments is the one of the ReplicaSet their message names,
+// while the ones of the ReplicaSets and the pods are taken from their labels, and the one of the
+// StatefulSets is the revision they roll out. The attribute is omitted when the revision is unknown.
+func (kr *k8seventsReceiver) addControllerRevision(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitControllerRevision {
+		return
+	}
+	if revision, ok := kr.controllerRevision(ev); ok {
+		setLogRecordsStr(ld, attributeControllerRevision, revision)
+	}
+}
+
+func (kr *k8seventsReceiver) controllerRevision(ev *corev1.Event) (string, bool) {
+	switch ev.InvolvedObject.Kind {
+	case "Deployment":
+		m := scaledReplicaSetRegexp.FindStringSubmatch(ev.Message)
+		if m == nil {
+			return "", false
+		}
+		rs := &corev1.ObjectReference{Kind: "ReplicaSet", Namespace: ev.InvolvedObject.Namespace, Name: m[1]}
+		if revision, ok := kr.revisionLabel(rs); ok {
+			return revision, true
+		}
+		// The ReplicaSets of the Deployments are named after the hash of their pod template.
+		hash, ok := strings.CutPrefix(m[1], ev.InvolvedObject.Name+"-")
+		return hash, ok && hash != ""
+	case "ReplicaSet", "Pod":
+		return kr.revisionLabel(&ev.InvolvedObject)
+	case "StatefulSet":
+		obj, ok := kr.involvedObject(ev)
+		if !ok {
+			return "", false
+		}
+		sts, ok := obj.(*appsv1.StatefulSet)
+		if !ok || sts.Status.UpdateRevision == "" {
+			return "", false
+		}
+		return sts.Status.UpdateRevision, true
+	default:
+		return "", false
+	}
+}
+
+// revisionLabel returns the revision label of the cached object referenced by ref,
+// i.e. the hash of the pod template or the controller revision.
+func (kr *k8seventsReceiver) revisionLabel(ref *corev1.ObjectReference) (string, bool) {
+	if kr.objectCache == nil {
+		return "", false
+	}
+	obj, ok := kr.objectCache.get(ref)
+	if !ok {
+		return "", false
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", false
+	}
+	labels := accessor.GetLabels()
+	for _, key := range []string{appsv1.DefaultDeploymentUniqueLabelKey, appsv1.ControllerRevisionHashLabelKey} {
+		if revision, ok := labels[key]; ok && revision != "" {
+			return revision, true
+		}
+	}
+	return "", false
+}
+
+// addJobStatus adds the number of the succeeded and the failed pods of the cached Job the event is about
+// to the log records of ld, and the CronJob owning the Job, if any, to the resources of ld. The events
+// about a CronJob get its name only. The attributes are omitted when the Job isn't cached.
+func (kr *k8seventsReceiver) addJobStatus(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitJobStatus {
+		return
+	}
+	var cronJob string
+	switch ev.InvolvedObject.Kind {
+	case "CronJob":
+		cronJob = ev.InvolvedObject.Name
+	case "Job":
+		obj, ok := kr.involvedObject(ev)
+		if !ok {
+			return
+		}
+		job, ok := obj.(*batchv1.Job)
+		if !ok {
+			return
+		}
+		attrs := pcommon.NewMap()
+		attrs.PutInt(attributeJobSucceeded, int64(job.Status.Succeeded))
+		attrs.PutInt(attributeJobFailed, int64(job.Status.Failed))
+		setLogRecordsAttributes(ld, attrs)
+		if owner := metav1.GetControllerOfNoCopy(job); owner != nil && owner.Kind == "CronJob" {
+			cronJob = owner.Name
+		}
+	}
+	if cronJob == "" {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(semconv.AttributeK8SCronJobName, cronJob)
+	}
+}
+
+// addStorageBinding adds the binding info of the cached PersistentVolumeClaim or PersistentVolume
+// the event is about to the log records of ld. For claims, the volume they are bound to, if any, is
+// described as well when cached. The attributes of the unbound claims and volumes are omitted.
+func (kr *k8seventsReceiver) addStorageBinding(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitStorageBinding {
+		return
+	}
+	kind := ev.InvolvedObject.Kind
+	if kind != "PersistentVolumeClaim" && kind != "PersistentVolume" {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	binding := pcommon.NewMap()
+	switch o := obj.(type) {
+	case *corev1.PersistentVolumeClaim:
+		if o.Spec.StorageClassName != nil && *o.Spec.StorageClassName != "" {
+			binding.PutStr(attributePVCStorageClass, *o.Spec.StorageClassName)
+		}
+		if o.Status.Phase != "" {
+			binding.PutStr(attributePVCPhase, string(o.Status.Phase))
+		}
+		if o.Spec.VolumeName == "" {
+			break
+		}
+		binding.PutStr(attributePVName, o.Spec.VolumeName)
+		pv, _ := kr.objectCache.get(&corev1.ObjectReference{Kind: "PersistentVolume", Name: o.Spec.VolumeName})
+		if pv, ok := pv.(*corev1.PersistentVolume); ok {
+			putVolumeState(binding, pv)
+		}
+	case *corev1.PersistentVolume:
+		if o.Spec.StorageClassName != "" {
+			binding.PutStr(attributePVStorageClass, o.Spec.StorageClassName)
+		}
+		putVolumeState(binding, o)
+		if o.Spec.ClaimRef != nil && o.Spec.ClaimRef.Name != "" {
+			binding.PutStr(attributePVCName, o.Spec.ClaimRef.Name)
+		}
+	default:
+		return
+	}
+	setLogRecordsAttributes(ld, binding)
+}
+
+// exceededQuotaRegexp matches the name of the quota in the messages of the events rejected by the
+// ResourceQuota admission, e.g. `exceeded quota: compute, requested: cpu=2, used: cpu=3, limited: cpu=4`.
+var exceededQuotaRegexp = regexp.MustCompile(`exceeded quota: ([^,\s]+)`)
+
+// addResourceQuota adds the usage and the limits of the cached ResourceQuota the event is about, or the event's
+// namespace exceeded according to its message, to the log records of ld, as capacity context. The attributes are
+// omitted when the quota isn't cached.
+func (kr *k8seventsReceiver) addResourceQuota(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitResourceQuota || kr.objectCache == nil {
+		return
+	}
+	ref := &ev.InvolvedObject
+	if ref.Kind != "ResourceQuota" {
+		match := exceededQuotaRegexp.FindStringSubmatch(ev.Message)
+		if match == nil {
+			return
+		}
+		ns := ev.InvolvedObject.Namespace
+		if ns == "" {
+			ns = ev.Namespace
+		}
+		ref = &corev1.ObjectReference{Kind: "ResourceQuota", Namespace: ns, Name: match[1]}
+	}
+	obj, ok := kr.objectCache.get(ref)
+	if !ok {
+		return
+	}
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return
+	}
+	attrs := pcommon.NewMap()
+	attrs.PutStr(attributeResourceQuotaName, quota.Name)
+	putResourceList(attrs.PutEmptyMap(attributeResourceQuotaUsed), quota.Status.Used)
+	putResourceList(attrs.PutEmptyMap(attributeResourceQuotaHard), quota.Status.Hard)
+	setLogRecordsAttributes(ld, attrs)
+}
+
+// putResourceList puts the quantities of resources into attrs, keyed by resource name.
+func putResourceList(attrs pcommon.Map, resources corev1.ResourceList) {
+	attrs.EnsureCapacity(len(resources))
+	for name, quantity := range resources {
+		attrs.PutStr(string(name), quantity.String())
+	}
+}
+
+// putVolumeState puts the phase and the capacity of the volume pv into attrs.
+func putVolumeState(attrs pcommon.Map, pv *corev1.PersistentVolume) {
+	if pv.Status.Phase != "" {
+		attrs.PutStr(attributePVPhase, string(pv.Status.Phase))
+	}
+	if capacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		attrs.PutStr(attributePVCapacity, capacity.String())
+	}
+}
+
+// addServiceNetwork adds the connection info of the cached Service or Endpoints
+// the event is about to the log records of ld. Headless services have no address.
+func (kr *k8seventsReceiver) addServiceNetwork(ld plog.Logs, ev *corev1.Event) {
+	if !kr.config.EmitServiceNetwork {
+		return
+	}
+	kind := ev.InvolvedObject.Kind
+	if kind != "Service" && kind != "Endpoints" {
+		return
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return
+	}
+	network := pcommon.NewMap()
+	switch o := obj.(type) {
+	case *corev1.Service:
+		if o.Spec.ClusterIP != "" && o.Spec.ClusterIP != corev1.ClusterIPNone {
+			network.PutStr(semconv.AttributeNetworkPeerAddress, o.Spec.ClusterIP)
+		}
+		ports := network.PutEmptySlice(attributeServicePorts)
+		for _, p := range o.Spec.Ports {
+			ports.AppendEmpty().SetInt(int64(p.Port))
+		}
+	case *corev1.Endpoints:
+		addresses := network.PutEmptySlice(attributeEndpointsAddresses)
+		ports := network.PutEmptySlice(attributeEndpointsPorts)
+		for _, subset := range o.Subsets {
+			for _, a := range subset.Addresses {
+				addresses.AppendEmpty().SetStr(a.IP)
+			}
+			for _, p := range subset.Ports {
+				ports.AppendEmpty().SetInt(int64(p.Port))
+			}
+		}
+	default:
+		return
+	}
+	setLogRecordsAttributes(ld, network)
+}
+
+// containerFromFieldPath returns the name of the container referenced by
+// the field path of an involved object, e.g. `spec.containers{nginx}`.
+func containerFromFieldPath(fieldPath string) string {
+	start := strings.IndexByte(fieldPath, '{')
+	end := strings.LastIndexByte(fieldPath, '}')
+	if start < 0 || end < start {
+		return ""
+	}
+	return fieldPath[start+1 : end]
+}
+
+// lastTermination returns the last termination of the named container of pod.
+// Without a name, the container is only resolved when the pod has a single one.
+func lastTermination(pod *corev1.Pod, name string) *corev1.ContainerStateTerminated {
+	if name == "" {
+		if len(pod.Status.ContainerStatuses) != 1 {
+			return nil
+		}
+		return pod.Status.ContainerStatuses[0].LastTerminationState.Terminated
+	}
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses} {
+		for i := range statuses {
+			if statuses[i].Name == name {
+				return statuses[i].LastTerminationState.Terminated
+			}
+		}
+	}
+	return nil
+}
+
+// Drop events occurring shortly after the creation of their involved object,
+// since they are mostly transient startup noise. Events about objects
+// which are not cached are always allowed.
+func (kr *k8seventsReceiver) allowInvolvedObjectAge(ev *corev1.Event) bool {
+	if kr.config.MinInvolvedObjectAge <= 0 {
+		return true
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return true
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return true
+	}
+	age := getEventTimestamp(ev).Sub(accessor.GetCreationTimestamp().Time)
+	return age >= kr.config.MinInvolvedObjectAge
+}
+
+// Allow only the events about objects carrying all the annotations of the selector.
+// Events about objects which are not cached are handled as configured.
+func (kr *k8seventsReceiver) allowInvolvedObjectAnnotations(ev *corev1.Event) bool {
+	selector := &kr.config.InvolvedObjectAnnotationSelector
+	if len(selector.MatchAnnotations) == 0 {
+		return true
+	}
+	obj, ok := kr.involvedObject(ev)
+	if !ok {
+		return selector.NotCached == notCachedActionAllow
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return selector.NotCached == notCachedActionAllow
+	}
+	annotations := accessor.GetAnnotations()
+	for k, v := range selector.MatchAnnotations {
+		if value, ok := annotations[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// selectedDeployment returns the name of the selected deployment the involved object of the event belongs to.
+func (kr *k8seventsReceiver) selectedDeployment(ev *corev1.Event) (string, bool) {
+	if kr.objectCache == nil {
+		return "", false
+	}
+	name, ok := deploymentOf(&ev.InvolvedObject, kr.ownerChain(&ev.InvolvedObject))
+	if !ok || !slices.Contains(kr.config.WorkloadSelector.Deployments, ev.InvolvedObject.Namespace+"/"+name) {
+		return "", false
+	}
+	return name, true
+}
+
+// Allow only the events about the selected workloads, if any.
+func (kr *k8seventsReceiver) allowWorkload(ev *corev1.Event) bool {
+	if len(kr.config.WorkloadSelector.Deployments) == 0 {
+		return true
+	}
+	_, ok := kr.selectedDeployment(ev)
+	return ok
+}
+
+// addWorkload adds the name of the selected deployment the event is about to all the resources of ld.
+func (kr *k8seventsReceiver) addWorkload(ld plog.Logs, ev *corev1.Event) {
+	if len(kr.config.WorkloadSelector.Deployments) == 0 {
+		return
+	}
+	name, ok := kr.selectedDeployment(ev)
+	if !ok {
+		return
+	}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rls.At(i).Resource().Attributes().PutStr(semconv.AttributeK8SDeploymentName, name)
+	}
+}
+
+// contains reports whether t falls into any of the maintenance windows.
+func (cfg *MaintenanceConfig) contains(t time.Time) bool {
+	for _, w := range cfg.Windows {
+		if !t.Before(w.Start) && t.Before(w.End) {
+			return true
+		}
+	}
+	return false
 }
 
 // Return the EventTimestamp based on the populated k8s event timestamps.
 // Priority: EventTime > LastTimestamp > FirstTimestamp.
+// The microsecond precision of EventTime is preserved, while the other timestamps have a second precision.
 func getEventTimestamp(ev *corev1.Event) time.Time {
 	var eventTimestamp time.Time
 
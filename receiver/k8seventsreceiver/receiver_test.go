@@ -5,22 +5,55 @@ package k8seventsreceiver
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/extensionauth"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadatatest"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -50,24 +83,2350 @@ func TestNewReceiver(t *testing.T) {
 	require.NotNil(t, r1)
 	require.NoError(t, r1.Start(context.Background(), componenttest.NewNopHost()))
 	assert.NoError(t, r1.Shutdown(context.Background()))
+
+	rCfg.Enrichment.Enabled = true
+	r2, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		consumertest.NewNop(),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, r2)
+	require.NoError(t, r2.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NotNil(t, r2.(*k8seventsReceiver).objectCache)
+	assert.NoError(t, r2.Shutdown(context.Background()))
+}
+
+// failingMeterProvider provides meters failing to create the instruments, as when the telemetry can't be registered.
+type failingMeterProvider struct {
+	noopmetric.MeterProvider
+}
+
+func (failingMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return failingMeter{}
+}
+
+type failingMeter struct {
+	noopmetric.Meter
+}
+
+func (failingMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return nil, errors.New("instrument registration failed")
+}
+
+func (failingMeter) Int64Gauge(string, ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return nil, errors.New("instrument registration failed")
+}
+
+func TestNewReceiverWithFailingTelemetry(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	set := receivertest.NewNopSettings(metadata.Type)
+	set.Logger = zap.New(core)
+	set.MeterProvider = failingMeterProvider{}
+
+	client := fake.NewClientset()
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Namespaces = []string{"test"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(set, rCfg, sink)
+	require.NoError(t, err)
+	assert.Equal(t, 2, logs.FilterMessage("failed to register the receiver telemetry, the events are received without it").Len())
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, r.Shutdown(context.Background())) })
+	_, err = client.CoreV1().Events("test").Create(context.Background(), getEvent(), v1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// authExtension is an auth extension setting the Authorization header of the requests.
+type authExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	extensionauth.ClientRoundTripperFunc
+}
+
+// extensionsHost is a host providing extensions.
+type extensionsHost struct {
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestStartWithAuthExtension(t *testing.T) {
+	authorized := make(chan string, 10)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case authorized <- r.Header.Get("Authorization"):
+		default:
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind":"EventList","apiVersion":"v1","metadata":{"resourceVersion":"1"},"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	t.Setenv("KUBERNETES_SERVICE_HOST", serverURL.Hostname())
+	t.Setenv("KUBERNETES_SERVICE_PORT", serverURL.Port())
+
+	authID := component.MustNewID("testauth")
+	host := extensionsHost{extensions: map[component.ID]component.Component{
+		authID: authExtension{ClientRoundTripperFunc: func(base http.RoundTripper) (http.RoundTripper, error) {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				r = r.Clone(r.Context())
+				r.Header.Set("Authorization", "Bearer rotated-token")
+				return base.RoundTrip(r)
+			}), nil
+		}},
+	}}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.AuthType = k8sconfig.AuthTypeNone
+	rCfg.Auth = &configauth.Authentication{AuthenticatorID: authID}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	require.NoError(t, recv.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	select {
+	case header := <-authorized:
+		assert.Equal(t, "Bearer rotated-token", header)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no request received by the API server")
+	}
+}
+
+func TestStartWithMissingAuthExtension(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Auth = &configauth.Authentication{AuthenticatorID: component.MustNewID("missing")}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	err := recv.Start(context.Background(), extensionsHost{})
+	assert.ErrorContains(t, err, "failed to resolve the auth extension")
+	require.NoError(t, recv.Shutdown(context.Background()))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestStartWithFallbackToAccessibleNamespaces(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.FallbackToAccessibleNamespaces = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return newRestrictedClient("team-a"), nil
+	}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, recv.Shutdown(context.Background())) })
+	assert.Equal(t, []string{"team-a"}, recv.namespaces)
+	rCfg.EmitMatchedFilters = true
+	k8sEvent := getEvent()
+	k8sEvent.Namespace = "team-a"
+	matched, _, ok := recv.allowEvent(k8sEvent)
+	require.True(t, ok)
+	assert.Equal(t, []string{"namespaces", "start_time"}, matched)
+
+	// Without the fallback, the whole cluster is watched regardless.
+	rCfg.FallbackToAccessibleNamespaces = false
+	clusterRecv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	require.NoError(t, clusterRecv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, clusterRecv.Shutdown(context.Background())) })
+	assert.Equal(t, []string{corev1.NamespaceAll}, clusterRecv.namespaces)
+}
+
+func TestWatchActiveTelemetry(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	client := fake.NewClientset()
+	client.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "forbidden" {
+			return true, nil, errors.New("events is forbidden")
+		}
+		return false, nil, nil
+	})
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Namespaces = []string{"test", "forbidden"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	expected := func(test, forbidden int64) []metricdata.DataPoint[int64] {
+		return []metricdata.DataPoint[int64]{
+			{Value: test, Attributes: attribute.NewSet(attribute.String("namespace", "test"))},
+			{Value: forbidden, Attributes: attribute.NewSet(attribute.String("namespace", "forbidden"))},
+		}
+	}
+	assert.Eventually(t, func() bool {
+		got, err := tel.GetMetric("otelcol_k8sevents_watch_active")
+		if err != nil {
+			return false
+		}
+		values := map[string]int64{}
+		for _, dp := range got.Data.(metricdata.Gauge[int64]).DataPoints {
+			ns, _ := dp.Attributes.Value("namespace")
+			values[ns.AsString()] = dp.Value
+		}
+		forbidden, ok := values["forbidden"]
+		return values["test"] == 1 && ok && forbidden == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	metadatatest.AssertEqualK8seventsWatchActive(t, tel, expected(1, 0), metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	metadatatest.AssertEqualK8seventsWatchActive(t, tel, expected(0, 0), metricdatatest.IgnoreTimestamp())
+}
+
+func TestWatchedNamespacesTelemetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces []string
+		expected   int64
+	}{
+		{name: "cluster", expected: 1},
+		{name: "namespaced", namespaces: []string{"test", "other", "third"}, expected: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := componenttest.NewTelemetry()
+			t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.Namespaces = tt.namespaces
+			rCfg.StartupJitter = 20 * time.Millisecond
+			rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+				return fake.NewClientset(), nil
+			}
+			r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, consumertest.NewNop())
+			require.NoError(t, err)
+			require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+			// The watches start once delayed by the startup jitter.
+			assert.Eventually(t, func() bool {
+				got, err := tel.GetMetric("otelcol_k8sevents_watched_namespaces")
+				return err == nil && got.Data.(metricdata.Gauge[int64]).DataPoints[0].Value == tt.expected
+			}, 5*time.Second, 10*time.Millisecond)
+
+			require.NoError(t, r.Shutdown(context.Background()))
+			metadatatest.AssertEqualK8seventsWatchedNamespaces(t, tel,
+				[]metricdata.DataPoint[int64]{{Value: 0}}, metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
+
+func TestHandleEventWithEnrichmentFallback(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		fallback string
+		synced   bool
+		pod      string
+		allowed  bool
+		reason   string
+	}{
+		{name: "found", fallback: "drop", synced: true, pod: "test-34bcd-rn54", allowed: true},
+		{name: "not_found_emit_without", fallback: "emit_without", synced: true, pod: "unknown-pod", allowed: true, reason: "not_found"},
+		{name: "not_found_drop", fallback: "drop", synced: true, pod: "unknown-pod", allowed: false, reason: "not_found"},
+		{name: "not_synced_drop", fallback: "drop", synced: false, pod: "test-34bcd-rn54", allowed: false, reason: "not_synced"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := componenttest.NewTelemetry()
+			t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.Enrichment.Enabled = true
+			rCfg.Enrichment.Timeout = 10 * time.Millisecond
+			rCfg.Enrichment.Fallback = tt.fallback
+			sink := new(consumertest.LogsSink)
+			r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, sink)
+			require.NoError(t, err)
+			recv := r.(*k8seventsReceiver)
+			recv.ctx = context.Background()
+			if tt.synced {
+				recv.objectCache = newTestObjectCache(t, []string{"Pod"}, pod)
+			} else {
+				recv.objectCache, err = newObjectCache(fake.NewClientset(pod), []string{"Pod"})
+				require.NoError(t, err)
+			}
+
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Name = tt.pod
+			k8sEvent.InvolvedObject.UID = ""
+			recv.handleEvent(k8sEvent)
+			if tt.allowed {
+				assert.Equal(t, 1, sink.LogRecordCount())
+			} else {
+				assert.Equal(t, 0, sink.LogRecordCount())
+			}
+
+			if tt.reason == "" {
+				_, err = tel.GetMetric("otelcol_k8sevents_enrichment_misses")
+				assert.Error(t, err)
+				return
+			}
+			metadatatest.AssertEqualK8seventsEnrichmentMisses(t, tel, []metricdata.DataPoint[int64]{
+				{Value: 1, Attributes: attribute.NewSet(attribute.String("reason", tt.reason))},
+			}, metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
+
+func TestStartupDelay(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	assert.Zero(t, recv.startupDelay())
+
+	rCfg.StartupJitter = 50 * time.Millisecond
+	for i := 0; i < 1000; i++ {
+		delay := recv.startupDelay()
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, rCfg.StartupJitter)
+	}
+}
+
+func TestStartWithResyncPeriod(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.ResourceVersion = "5"
+	k8sEvent.FirstTimestamp = v1.NewTime(time.Now().Add(time.Hour))
+	client := fake.NewClientset(k8sEvent)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ResyncPeriod = 10 * time.Millisecond
+	rCfg.FirstOccurrenceOnly.Enabled = false
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	// The resyncs redeliver the event unchanged, which isn't emitted again.
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	// The updates of the event are still emitted.
+	k8sEvent.Count = 3
+	k8sEvent.ResourceVersion = "6"
+	_, err := client.CoreV1().Events("test").Update(context.Background(), k8sEvent, v1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestIsResync(t *testing.T) {
+	event := func(rv string) *corev1.Event {
+		return &corev1.Event{ObjectMeta: v1.ObjectMeta{ResourceVersion: rv}}
+	}
+	assert.True(t, isResync(event("5"), event("5")))
+	assert.False(t, isResync(event("5"), event("6")))
+	assert.False(t, isResync(event(""), event("")))
+	assert.False(t, isResync("not an object", event("5")))
+}
+
+func TestStartWithListPageSize(t *testing.T) {
+	pages := map[string]*corev1.EventList{
+		"": {
+			ListMeta: v1.ListMeta{ResourceVersion: "10", Continue: "page-2"},
+			Items:    []corev1.Event{*getEvent()},
+		},
+		"page-2": {
+			ListMeta: v1.ListMeta{ResourceVersion: "10"},
+			Items:    []corev1.Event{*getEvent()},
+		},
+	}
+	pages["page-2"].Items[0].Name = "2"
+	pages["page-2"].Items[0].UID = types.UID("7c3b-91ea")
+	for _, page := range pages {
+		page.Items[0].FirstTimestamp = v1.NewTime(time.Now().Add(time.Hour))
+	}
+
+	var mu sync.Mutex
+	var requested []v1.ListOptions
+	client := fake.NewClientset()
+	client.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		options := action.(k8stesting.ListActionImpl).ListOptions
+		mu.Lock()
+		defer mu.Unlock()
+		requested = append(requested, options)
+		return true, pages[options.Continue].DeepCopy(), nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ListPageSize = 1
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	require.GreaterOrEqual(t, len(requested), 2)
+	assert.Equal(t, int64(1), requested[0].Limit)
+	assert.Empty(t, requested[0].ResourceVersion)
+	assert.Equal(t, "page-2", requested[1].Continue)
+}
+
+func TestStartWithStartupJitter(t *testing.T) {
+	client := fake.NewClientset()
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.StartupJitter = time.Hour
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), rCfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	recv := r.(*k8seventsReceiver)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	// The watch is pending, nothing has been listed yet.
+	assert.Empty(t, client.Actions())
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	rCfg.StartupJitter = time.Millisecond
+	r, err = newReceiver(receivertest.NewNopSettings(metadata.Type), rCfg, consumertest.NewNop())
+	require.NoError(t, err)
+	recv = r.(*k8seventsReceiver)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return len(client.Actions()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, recv.Shutdown(context.Background()))
+}
+
+func TestHandleEvent(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		sink,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+	k8sEvent := getEvent()
+	recv.handleEvent(k8sEvent)
+
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestHandleEventWithInternalLatency(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	_, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeInternalLatency)
+	assert.False(t, ok)
+
+	rCfg.EmitInternalLatency = true
+	sink.Reset()
+	// The event has been queued for 50ms since its delivery by the informer.
+	recv.handleReceivedEvent(getEvent(), time.Now().Add(-50*time.Millisecond))
+	require.Equal(t, 1, sink.LogRecordCount())
+	latency, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeInternalLatency)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, latency.Double(), 50.0)
+	assert.Less(t, latency.Double(), 5000.0)
+}
+
+func TestHandleEventWithRouting(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Routing.Attribute = "k8s.event.route"
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	tests := []struct {
+		name          string
+		eventType     string
+		defaultRoute  string
+		expectedRoute string
+	}{
+		{name: "warning", eventType: "Warning", expectedRoute: "critical"},
+		{name: "normal", eventType: "Normal", expectedRoute: "bulk"},
+		{name: "case_insensitive", eventType: "warning", expectedRoute: "critical"},
+		{name: "unrouted", eventType: "Custom"},
+		{name: "default_route", eventType: "Custom", defaultRoute: "bulk", expectedRoute: "bulk"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			rCfg.Routing.Default = tt.defaultRoute
+			k8sEvent := getEvent()
+			k8sEvent.Type = tt.eventType
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			route, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("k8s.event.route")
+			require.Equal(t, tt.expectedRoute != "", ok)
+			if ok {
+				assert.Equal(t, tt.expectedRoute, route.Str())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithInstanceID(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EmitInstanceID = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+
+	require.Len(t, sink.AllLogs(), 2)
+	first, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorInstanceID)
+	require.True(t, ok)
+	_, err := uuid.Parse(first.Str())
+	require.NoError(t, err)
+	second, ok := sink.AllLogs()[1].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorInstanceID)
+	require.True(t, ok)
+	assert.Equal(t, first.Str(), second.Str())
+
+	// Another receiver, as created by another collector process, has its own ID.
+	otherSink := new(consumertest.LogsSink)
+	newTestReceiver(t, rCfg, otherSink).handleEvent(getEvent())
+	other, ok := otherSink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorInstanceID)
+	require.True(t, ok)
+	assert.NotEqual(t, first.Str(), other.Str())
+}
+
+func TestHandleEventWithCollectorNamespace(t *testing.T) {
+	t.Setenv("TEST_COLLECTOR_NAMESPACE", "observability")
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.CollectorNamespace = CollectorNamespaceConfig{
+		Enabled: true,
+		EnvVar:  "TEST_COLLECTOR_NAMESPACE",
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.handleEvent(getEvent())
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorNamespace)
+	require.True(t, ok)
+	assert.Equal(t, "observability", attr.Str())
+}
+
+func TestHandleEventWithCollectorNamespaceUnset(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.CollectorNamespace = CollectorNamespaceConfig{
+		Enabled: true,
+		EnvVar:  "TEST_COLLECTOR_NAMESPACE_UNSET",
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.handleEvent(getEvent())
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	_, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorNamespace)
+	assert.False(t, ok)
+}
+
+func TestHandleEventWithCollectorVersion(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EmitCollectorVersion = true
+	set := receivertest.NewNopSettings(metadata.Type)
+	set.BuildInfo = component.BuildInfo{
+		Command: "otelcontribcol",
+		Version: "0.125.0-test",
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(set, rCfg, sink)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+	recv.handleEvent(getEvent())
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeCollectorVersion)
+	require.True(t, ok)
+	assert.Equal(t, "0.125.0-test", attr.Str())
+}
+
+func TestHandleEventWithAPIServerEndpoint(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.96.0.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.AuthType = k8sconfig.AuthTypeNone
+	rCfg.EmitAPIServerEndpoint = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+	recv.handleEvent(getEvent())
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeAPIServerEndpoint)
+	require.True(t, ok)
+	assert.Equal(t, "https://10.96.0.1:443", attr.Str())
+}
+
+func TestHandleEventWithCloudProvider(t *testing.T) {
+	node := func(providerID string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: v1.ObjectMeta{Name: "node-1"},
+			Spec:       corev1.NodeSpec{ProviderID: providerID},
+		}
+	}
+	tests := []struct {
+		name     string
+		config   CloudProviderConfig
+		nodes    []runtime.Object
+		expected string
+	}{
+		{
+			name:     "configured",
+			config:   CloudProviderConfig{Name: "gcp"},
+			expected: "gcp",
+		},
+		{
+			name:     "configured_over_detected",
+			config:   CloudProviderConfig{Name: "gcp", Detect: true},
+			nodes:    []runtime.Object{node("aws:///us-east-1a/i-0abc")},
+			expected: "gcp",
+		},
+		{
+			name:     "detected_aws",
+			config:   CloudProviderConfig{Detect: true},
+			nodes:    []runtime.Object{node("aws:///us-east-1a/i-0abc")},
+			expected: "aws",
+		},
+		{
+			name:     "detected_gcp",
+			config:   CloudProviderConfig{Detect: true},
+			nodes:    []runtime.Object{node("gce://my-project/europe-west1-b/node-1")},
+			expected: "gcp",
+		},
+		{
+			name:     "detected_azure",
+			config:   CloudProviderConfig{Detect: true},
+			nodes:    []runtime.Object{node("azure:///subscriptions/1234/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/node-1")},
+			expected: "azure",
+		},
+		{
+			name:   "unknown_provider",
+			config: CloudProviderConfig{Detect: true},
+			nodes:  []runtime.Object{node("kind://docker/kind/kind-control-plane")},
+		},
+		{
+			name:   "no_provider_id",
+			config: CloudProviderConfig{Detect: true},
+			nodes:  []runtime.Object{node("")},
+		},
+		{
+			name:   "no_nodes",
+			config: CloudProviderConfig{Detect: true},
+		},
+		{
+			name:  "detection_disabled",
+			nodes: []runtime.Object{node("aws:///us-east-1a/i-0abc")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientset(tt.nodes...)
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.CloudProvider = tt.config
+			rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+				return client, nil
+			}
+			sink := new(consumertest.LogsSink)
+			recv := newTestReceiver(t, rCfg, sink)
+			require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+			recv.handleEvent(getEvent())
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(semconv.AttributeCloudProvider)
+			require.Equal(t, tt.expected != "", ok)
+			if ok {
+				assert.Equal(t, tt.expected, attr.Str())
+			}
+		})
+	}
+}
+
+func TestDetectCloudProviderFailure(t *testing.T) {
+	client := fake.NewClientset()
+	client.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("nodes is forbidden")
+	})
+	_, err := detectCloudProvider(context.Background(), client)
+	assert.EqualError(t, err, "failed to list the nodes: nodes is forbidden")
+}
+
+func TestHandleEventScope(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EmitShutdownSummary = true
+	set := receivertest.NewNopSettings(metadata.Type)
+	set.BuildInfo = component.BuildInfo{
+		Command: "otelcontribcol",
+		Version: "0.125.0-test",
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(set, rCfg, sink)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx, recv.cancel = context.WithCancel(context.Background())
+	recv.handleEvent(getEvent())
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	// Both the event and the shutdown summary are scoped.
+	require.Len(t, sink.AllLogs(), 2)
+	for _, ld := range sink.AllLogs() {
+		rl := ld.ResourceLogs().At(0)
+		assert.Equal(t, semconv.SchemaURL, rl.SchemaUrl())
+		sl := rl.ScopeLogs().At(0)
+		assert.Equal(t, semconv.SchemaURL, sl.SchemaUrl())
+		assert.Equal(t, "k8s_events", sl.Scope().Name())
+		assert.Equal(t, "0.125.0-test", sl.Scope().Version())
+	}
+}
+
+func TestHandleEventWithSortAttributes(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.SortAttributes = true
+	rCfg.NamespaceResourceAttributes = map[string]map[string]string{
+		"test": {"team": "payments", "tier": "backend", "cost-center": "42", "owner": "web", "region": "eu"},
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	// The namespace attributes are inserted in the random order of the Go maps.
+	var expected []string
+	for range 10 {
+		sink.Reset()
+		recv.handleEvent(getEvent())
+		require.Equal(t, 1, sink.LogRecordCount())
+		rl := sink.AllLogs()[0].ResourceLogs().At(0)
+		orderedKeys := append(attributeKeys(rl.Resource().Attributes()), attributeKeys(rl.ScopeLogs().At(0).LogRecords().At(0).Attributes())...)
+		if expected == nil {
+			expected = orderedKeys
+		}
+		assert.Equal(t, expected, orderedKeys)
+	}
+	assert.True(t, slices.IsSorted(attributeKeys(sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes())))
+}
+
+func TestHandleEventWithNamespaceResourceAttributes(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", "collector")
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.CollectorNamespace.Enabled = true
+	rCfg.NamespaceResourceAttributes = map[string]map[string]string{
+		"test": {
+			"team":                    "payments",
+			"k8s.collector.namespace": "overridden",
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	team, ok := attrs.Get("team")
+	require.True(t, ok)
+	assert.Equal(t, "payments", team.Str())
+	ns, ok := attrs.Get(attributeCollectorNamespace)
+	require.True(t, ok)
+	assert.Equal(t, "overridden", ns.Str())
+
+	// The attributes only apply to the events of their namespace.
+	sink.Reset()
+	k8sEvent := getEvent()
+	k8sEvent.Namespace = "other"
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	attrs = sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	_, ok = attrs.Get("team")
+	assert.False(t, ok)
+	ns, ok = attrs.Get(attributeCollectorNamespace)
+	require.True(t, ok)
+	assert.Equal(t, "collector", ns.Str())
+}
+
+func TestEventFromObject(t *testing.T) {
+	recv := newTestReceiver(t, createDefaultConfig().(*Config), consumertest.NewNop())
+	k8sEvent := getEvent()
+
+	ev, ok := recv.eventFromObject(k8sEvent)
+	require.True(t, ok)
+	assert.Same(t, k8sEvent, ev)
+
+	ev, ok = recv.eventFromObject(cache.DeletedFinalStateUnknown{Key: "test/1", Obj: k8sEvent})
+	require.True(t, ok)
+	assert.Same(t, k8sEvent, ev)
+
+	_, ok = recv.eventFromObject(cache.DeletedFinalStateUnknown{Key: "test/1", Obj: &corev1.Pod{}})
+	assert.False(t, ok)
+
+	_, ok = recv.eventFromObject(cache.DeletedFinalStateUnknown{Key: "test/1"})
+	assert.False(t, ok)
+
+	_, ok = recv.eventFromObject(&corev1.Pod{})
+	assert.False(t, ok)
+}
+
+func TestHandleEventDuringMaintenance(t *testing.T) {
+	now := time.Now()
+	window := TimeRange{Start: now.Add(-time.Minute), End: now.Add(time.Minute)}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Maintenance.Windows = []TimeRange{window}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	k8sEvent := getEvent()
+	k8sEvent.FirstTimestamp = v1.NewTime(window.End)
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	_, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeMaintenance)
+	assert.False(t, ok)
+
+	rCfg.Maintenance.Action = maintenanceActionFlag
+	sink.Reset()
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeMaintenance)
+	require.True(t, ok)
+	assert.True(t, attr.Bool())
+}
+
+func TestHandleEventWithMinInvolvedObjectAge(t *testing.T) {
+	now := time.Now()
+	newPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "new-pod",
+			Namespace:         "test",
+			CreationTimestamp: v1.NewTime(now.Add(-10 * time.Second)),
+		},
+	}
+	oldPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "old-pod",
+			Namespace:         "test",
+			CreationTimestamp: v1.NewTime(now.Add(-time.Hour)),
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.MinInvolvedObjectAge = time.Minute
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, newPod, oldPod)
+
+	tests := []struct {
+		name    string
+		pod     string
+		allowed bool
+	}{
+		{name: "new_pod", pod: "new-pod", allowed: false},
+		{name: "old_pod", pod: "old-pod", allowed: true},
+		{name: "not_cached", pod: "unknown-pod", allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Name = tt.pod
+			k8sEvent.InvolvedObject.UID = ""
+			recv.handleEvent(k8sEvent)
+			if tt.allowed {
+				assert.Equal(t, 1, sink.LogRecordCount())
+			} else {
+				assert.Equal(t, 0, sink.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithInvolvedObjectAnnotationSelector(t *testing.T) {
+	monitoredPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "monitored-pod",
+			Namespace:   "test",
+			Annotations: map[string]string{"monitoring": "enabled"},
+		},
+	}
+	disabledPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "disabled-pod",
+			Namespace:   "test",
+			Annotations: map[string]string{"monitoring": "disabled"},
+		},
+	}
+	plainPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "plain-pod",
+			Namespace: "test",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		pod       string
+		notCached string
+		allowed   bool
+	}{
+		{name: "matching", pod: "monitored-pod", notCached: "drop", allowed: true},
+		{name: "different_value", pod: "disabled-pod", notCached: "drop", allowed: false},
+		{name: "not_annotated", pod: "plain-pod", notCached: "drop", allowed: false},
+		{name: "not_cached_drop", pod: "unknown-pod", notCached: "drop", allowed: false},
+		{name: "not_cached_allow", pod: "unknown-pod", notCached: "allow", allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.Enrichment.Enabled = true
+			rCfg.InvolvedObjectAnnotationSelector.MatchAnnotations = map[string]string{"monitoring": "enabled"}
+			rCfg.InvolvedObjectAnnotationSelector.NotCached = tt.notCached
+			sink := new(consumertest.LogsSink)
+			recv := newTestReceiver(t, rCfg, sink)
+			recv.objectCache = newTestObjectCache(t, []string{"Pod"}, monitoredPod, disabledPod, plainPod)
+
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Name = tt.pod
+			k8sEvent.InvolvedObject.UID = ""
+			recv.handleEvent(k8sEvent)
+			if tt.allowed {
+				assert.Equal(t, 1, sink.LogRecordCount())
+			} else {
+				assert.Equal(t, 0, sink.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithContainerTermination(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "app",
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+					},
+				},
+				{
+					Name: "sidecar",
+				},
+			},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.EmitContainerTermination = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, pod)
+
+	tests := []struct {
+		name      string
+		reason    string
+		fieldPath string
+		expected  bool
+	}{
+		{name: "crashing_container", reason: "BackOff", fieldPath: "spec.containers{app}", expected: true},
+		{name: "crash_loop", reason: "CrashLoopBackOff", fieldPath: "spec.containers{app}", expected: true},
+		{name: "not_terminated", reason: "BackOff", fieldPath: "spec.containers{sidecar}", expected: false},
+		{name: "ambiguous_container", reason: "BackOff", fieldPath: "", expected: false},
+		{name: "not_a_crash", reason: "Pulled", fieldPath: "spec.containers{app}", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.InvolvedObject.FieldPath = tt.fieldPath
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			reason, ok := attrs.Get(attributeContainerTerminationReason)
+			if !tt.expected {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, "OOMKilled", reason.Str())
+			exitCode, ok := attrs.Get(attributeContainerTerminationExitCode)
+			require.True(t, ok)
+			assert.Equal(t, int64(137), exitCode.Int())
+		})
+	}
+}
+
+func TestHandleEventWithProbeStatus(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.EmitProbeStatus = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, pod)
+
+	tests := []struct {
+		name      string
+		reason    string
+		message   string
+		podName   string
+		probeType string
+		ready     any
+	}{
+		{
+			name:      "readiness",
+			reason:    "Unhealthy",
+			message:   "Readiness probe failed: HTTP probe failed with statuscode: 503",
+			probeType: "readiness",
+			ready:     false,
+		},
+		{
+			name:      "liveness",
+			reason:    "Unhealthy",
+			message:   "Liveness probe failed: Get \"http://10.0.0.1:8080/healthz\": context deadline exceeded",
+			probeType: "liveness",
+			ready:     false,
+		},
+		{
+			name:      "probe_warning",
+			reason:    "ProbeWarning",
+			message:   "Startup probe warning: Probe terminated redirects",
+			probeType: "startup",
+			ready:     false,
+		},
+		{
+			name:      "not_cached",
+			reason:    "Unhealthy",
+			message:   "Liveness probe failed: connection refused",
+			podName:   "web-2",
+			probeType: "liveness",
+		},
+		{
+			name:    "unknown_probe",
+			reason:  "Unhealthy",
+			message: "Probe failed",
+			ready:   false,
+		},
+		{
+			name:    "not_a_probe",
+			reason:  "BackOff",
+			message: "Readiness probe failed: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.Message = tt.message
+			if tt.podName != "" {
+				k8sEvent.InvolvedObject.Name = tt.podName
+				k8sEvent.InvolvedObject.UID = ""
+			}
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+			if tt.probeType == "" {
+				assert.NotContains(t, attrs, attributeProbeType)
+			} else {
+				assert.Equal(t, tt.probeType, attrs[attributeProbeType])
+			}
+			if tt.ready == nil {
+				assert.NotContains(t, attrs, attributePodReady)
+			} else {
+				assert.Equal(t, tt.ready, attrs[attributePodReady])
+			}
+		})
+	}
+
+	// The ready pods are flagged as such.
+	pod.Status.Conditions[1].Status = corev1.ConditionTrue
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, pod)
+	sink.Reset()
+	k8sEvent := getEvent()
+	k8sEvent.Reason = "Unhealthy"
+	k8sEvent.Message = "Liveness probe failed: connection refused"
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	ready, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributePodReady)
+	require.True(t, ok)
+	assert.True(t, ready.Bool())
+}
+
+func TestHandleEventWithJobStatus(t *testing.T) {
+	isController := true
+	failed := &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "backup-29000000",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "batch/v1",
+				Kind:       "CronJob",
+				Name:       "backup",
+				Controller: &isController,
+			}},
+		},
+		Status: batchv1.JobStatus{Succeeded: 1, Failed: 3},
+	}
+	standalone := &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{Name: "migrate", Namespace: "test"},
+		Status:     batchv1.JobStatus{Succeeded: 1},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Job"}
+	rCfg.EmitJobStatus = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, failed, standalone)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+		cronJob  string
+	}{
+		{
+			name:     "failed_job_of_cronjob",
+			object:   corev1.ObjectReference{Kind: "Job", Name: "backup-29000000", Namespace: "test"},
+			expected: map[string]any{attributeJobSucceeded: int64(1), attributeJobFailed: int64(3)},
+			cronJob:  "backup",
+		},
+		{
+			name:     "standalone_job",
+			object:   corev1.ObjectReference{Kind: "Job", Name: "migrate", Namespace: "test"},
+			expected: map[string]any{attributeJobSucceeded: int64(1), attributeJobFailed: int64(0)},
+		},
+		{
+			name:     "cronjob",
+			object:   corev1.ObjectReference{Kind: "CronJob", Name: "backup", Namespace: "test"},
+			expected: map[string]any{},
+			cronJob:  "backup",
+		},
+		{
+			name:     "missing_job",
+			object:   corev1.ObjectReference{Kind: "Job", Name: "report", Namespace: "test"},
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = "BackoffLimitExceeded"
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			rl := sink.AllLogs()[0].ResourceLogs().At(0)
+			attrs := rl.ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			for _, k := range []string{attributeJobSucceeded, attributeJobFailed} {
+				v, ok := attrs.Get(k)
+				expected, expectedOk := tt.expected[k]
+				require.Equal(t, expectedOk, ok, k)
+				if ok {
+					assert.Equal(t, expected, v.AsRaw(), k)
+				}
+			}
+			cronJob, ok := rl.Resource().Attributes().Get(semconv.AttributeK8SCronJobName)
+			require.Equal(t, tt.cronJob != "", ok)
+			if ok {
+				assert.Equal(t, tt.cronJob, cronJob.Str())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithReportingZone(t *testing.T) {
+	zoned := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{Name: "node-1", Labels: map[string]string{corev1.LabelTopologyZone: "eu-west-1a"}},
+	}
+	legacy := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{Name: "node-2", Labels: map[string]string{corev1.LabelFailureDomainBetaZone: "eu-west-1b"}},
+	}
+	unzoned := &corev1.Node{ObjectMeta: v1.ObjectMeta{Name: "node-3"}}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Node"}
+	rCfg.EmitReportingZone = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, zoned, legacy, unzoned)
+
+	tests := []struct {
+		name     string
+		modify   func(ev *corev1.Event)
+		expected string
+	}{
+		{
+			name: "kubelet_on_zoned_node",
+			modify: func(ev *corev1.Event) {
+				ev.ReportingController = "kubelet"
+				ev.ReportingInstance = "node-1"
+			},
+			expected: "eu-west-1a",
+		},
+		{
+			name: "kubelet_source_on_legacy_zoned_node",
+			modify: func(ev *corev1.Event) {
+				ev.Source = corev1.EventSource{Component: "kubelet", Host: "node-2"}
+			},
+			expected: "eu-west-1b",
+		},
+		{
+			name: "kubelet_on_unzoned_node",
+			modify: func(ev *corev1.Event) {
+				ev.Source = corev1.EventSource{Component: "kubelet", Host: "node-3"}
+			},
+		},
+		{
+			name: "kubelet_on_missing_node",
+			modify: func(ev *corev1.Event) {
+				ev.Source = corev1.EventSource{Component: "kubelet", Host: "node-4"}
+			},
+		},
+		{
+			name: "other_controller",
+			modify: func(ev *corev1.Event) {
+				ev.Source = corev1.EventSource{Component: "default-scheduler", Host: "node-1"}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			tt.modify(k8sEvent)
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			zone, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(semconv.AttributeCloudAvailabilityZone)
+			require.Equal(t, tt.expected != "", ok)
+			if ok {
+				assert.Equal(t, tt.expected, zone.Str())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithObjectHealth(t *testing.T) {
+	healthy := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "web-1", Namespace: "test"},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}},
+	}
+	degraded := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+		}},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "Node"}
+	rCfg.EmitObjectHealth = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, healthy, degraded)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected string
+	}{
+		{
+			name:     "healthy_pod",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "test"},
+			expected: objectHealthHealthy,
+		},
+		{
+			name:     "degraded_node",
+			object:   corev1.ObjectReference{Kind: "Node", Name: "node-1"},
+			expected: objectHealthDegraded,
+		},
+		{
+			name:   "missing_pod",
+			object: corev1.ObjectReference{Kind: "Pod", Name: "web-2", Namespace: "test"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			health, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get(attributeObjectHealth)
+			require.Equal(t, tt.expected != "", ok)
+			if ok {
+				assert.Equal(t, tt.expected, health.Str())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithControllerRevision(t *testing.T) {
+	isController := true
+	newRS := &appsv1.ReplicaSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-7d9f8c6b5",
+			Namespace: "test",
+			Labels:    map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "7d9f8c6b5"},
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "web",
+				Controller: &isController,
+			}},
+		},
+	}
+	newPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-7d9f8c6b5-x2vzq",
+			Namespace: "test",
+			Labels:    map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "7d9f8c6b5"},
+		},
+	}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "test"},
+		Status:     appsv1.StatefulSetStatus{CurrentRevision: "db-5b4f9d7c8", UpdateRevision: "db-6c8d4b9f7"},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "ReplicaSet", "StatefulSet"}
+	rCfg.EmitControllerRevision = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, newRS, newPod, sts)
+
+	// The events of a rollout from the revision 5cf8b9d4c to 7d9f8c6b5, whose old ReplicaSet is already gone.
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		message  string
+		expected string
+	}{
+		{
+			name:     "deployment_scaled_up_new_revision",
+			object:   corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test"},
+			message:  "Scaled up replica set web-7d9f8c6b5 to 3",
+			expected: "7d9f8c6b5",
+		},
+		{
+			name:     "deployment_scaled_down_old_revision",
+			object:   corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test"},
+			message:  "Scaled down replica set web-5cf8b9d4c to 0 from 1",
+			expected: "5cf8b9d4c",
+		},
+		{
+			name:     "replicaset_new_revision",
+			object:   corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-7d9f8c6b5", Namespace: "test"},
+			message:  "Created pod: web-7d9f8c6b5-x2vzq",
+			expected: "7d9f8c6b5",
+		},
+		{
+			name:     "pod_new_revision",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-7d9f8c6b5-x2vzq", Namespace: "test"},
+			message:  "Started container web",
+			expected: "7d9f8c6b5",
+		},
+		{
+			name:     "statefulset",
+			object:   corev1.ObjectReference{Kind: "StatefulSet", Name: "db", Namespace: "test"},
+			message:  "create Pod db-0 in StatefulSet db successful",
+			expected: "db-6c8d4b9f7",
+		},
+		{
+			name:    "deployment_without_replicaset",
+			object:  corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test"},
+			message: "Deployment has minimum availability.",
+		},
+		{
+			name:    "missing_pod",
+			object:  corev1.ObjectReference{Kind: "Pod", Name: "web-5cf8b9d4c-k7mzt", Namespace: "test"},
+			message: "Killing container web",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			k8sEvent.Message = tt.message
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			revision, ok := attrs.Get(attributeControllerRevision)
+			require.Equal(t, tt.expected != "", ok)
+			if ok {
+				assert.Equal(t, tt.expected, revision.Str())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithWorkloadGeneration(t *testing.T) {
+	rollingOut := &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "web", Namespace: "test", Generation: 5},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 4},
+	}
+	created := &appsv1.StatefulSet{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "test", Generation: 1},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Deployment", "StatefulSet"}
+	rCfg.EmitWorkloadGeneration = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, rollingOut, created)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+	}{
+		{
+			name:   "deployment_mid_rollout",
+			object: corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test"},
+			expected: map[string]any{
+				attributeWorkloadGeneration:         int64(5),
+				attributeWorkloadObservedGeneration: int64(4),
+			},
+		},
+		{
+			name:     "statefulset_not_observed",
+			object:   corev1.ObjectReference{Kind: "StatefulSet", Name: "db", Namespace: "test"},
+			expected: map[string]any{attributeWorkloadGeneration: int64(1)},
+		},
+		{
+			name:     "missing_deployment",
+			object:   corev1.ObjectReference{Kind: "Deployment", Name: "api", Namespace: "test"},
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = "ScalingReplicaSet"
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			for _, k := range []string{attributeWorkloadGeneration, attributeWorkloadObservedGeneration} {
+				v, ok := attrs.Get(k)
+				expected, expectedOk := tt.expected[k]
+				require.Equal(t, expectedOk, ok, k)
+				if ok {
+					assert.Equal(t, expected, v.AsRaw(), k)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEventWithResolveNodeName(t *testing.T) {
+	scheduled := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "test-34bcd-rn54", Namespace: "test"},
+		Spec:       corev1.PodSpec{NodeName: "worker-1"},
+	}
+	pending := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "pending", Namespace: "test"},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.ResolveNodeName = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, scheduled, pending)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected string
+	}{
+		{name: "pod", object: corev1.ObjectReference{Kind: "Pod", Name: "test-34bcd-rn54", Namespace: "test"}, expected: "worker-1"},
+		{name: "node", object: corev1.ObjectReference{Kind: "Node", Name: "worker-2"}, expected: "worker-2"},
+		{name: "pending_pod", object: corev1.ObjectReference{Kind: "Pod", Name: "pending", Namespace: "test"}, expected: "testHost"},
+		{name: "missing_pod", object: corev1.ObjectReference{Kind: "Pod", Name: "missing", Namespace: "test"}, expected: "testHost"},
+		{name: "deployment", object: corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "test"}, expected: "testHost"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attr, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("k8s.node.name")
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, attr.Str())
+		})
+	}
 }
 
-func TestHandleEvent(t *testing.T) {
+func TestHandleEventWithStorageBinding(t *testing.T) {
+	fast := "fast-ssd"
+	bound := &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "data", Namespace: "test"},
+		Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &fast, VolumeName: "pvc-1234"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	pending := &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "cache", Namespace: "test"},
+		Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &fast},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	stale := &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "stale", Namespace: "test"},
+		Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &fast, VolumeName: "pvc-5678"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	volume := &corev1.PersistentVolume{
+		ObjectMeta: v1.ObjectMeta{Name: "pvc-1234"},
+		Spec: corev1.PersistentVolumeSpec{
+			StorageClassName: fast,
+			Capacity:         corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			ClaimRef:         &corev1.ObjectReference{Name: "data", Namespace: "test"},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+	}
+
 	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"PersistentVolumeClaim", "PersistentVolume"}
+	rCfg.EmitStorageBinding = true
 	sink := new(consumertest.LogsSink)
-	r, err := newReceiver(
-		receivertest.NewNopSettings(metadata.Type),
-		rCfg,
-		sink,
-	)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, bound, pending, stale, volume)
+	// An object of an unexpected type cached as the volume of a claim is ignored.
+	require.NoError(t, recv.objectCache.informers["PersistentVolume"].GetStore().Add(&corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "pvc-5678"},
+	}))
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+	}{
+		{
+			name:   "bound_claim",
+			object: corev1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "data", Namespace: "test"},
+			expected: map[string]any{
+				attributePVCStorageClass: "fast-ssd",
+				attributePVCPhase:        "Bound",
+				attributePVName:          "pvc-1234",
+				attributePVPhase:         "Bound",
+				attributePVCapacity:      "10Gi",
+			},
+		},
+		{
+			name:   "unbound_claim",
+			object: corev1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "cache", Namespace: "test"},
+			expected: map[string]any{
+				attributePVCStorageClass: "fast-ssd",
+				attributePVCPhase:        "Pending",
+			},
+		},
+		{
+			name:   "volume",
+			object: corev1.ObjectReference{Kind: "PersistentVolume", Name: "pvc-1234"},
+			expected: map[string]any{
+				attributePVStorageClass: "fast-ssd",
+				attributePVPhase:        "Bound",
+				attributePVCapacity:     "10Gi",
+				attributePVCName:        "data",
+			},
+		},
+		{
+			name:   "unexpected_volume",
+			object: corev1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "stale", Namespace: "test"},
+			expected: map[string]any{
+				attributePVCStorageClass: "fast-ssd",
+				attributePVCPhase:        "Bound",
+				attributePVName:          "pvc-5678",
+			},
+		},
+		{
+			name:     "missing_claim",
+			object:   corev1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "logs", Namespace: "test"},
+			expected: map[string]any{},
+		},
+	}
+	storageAttributes := []string{
+		attributePVCName, attributePVCStorageClass, attributePVCPhase,
+		attributePVName, attributePVStorageClass, attributePVPhase, attributePVCapacity,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = "ProvisioningSucceeded"
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			for _, k := range storageAttributes {
+				v, ok := attrs.Get(k)
+				expected, expectedOk := tt.expected[k]
+				require.Equal(t, expectedOk, ok, k)
+				if ok {
+					assert.Equal(t, expected, v.AsRaw(), k)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEventWithResourceQuota(t *testing.T) {
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: v1.ObjectMeta{Name: "compute", Namespace: "test"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:  resource.MustParse("4"),
+				corev1.ResourceLimitsMemory: resource.MustParse("8Gi"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:  resource.MustParse("3"),
+				corev1.ResourceLimitsMemory: resource.MustParse("6Gi"),
+			},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"ResourceQuota"}
+	rCfg.EmitResourceQuota = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, quota)
+
+	expected := map[string]any{
+		attributeResourceQuotaName: "compute",
+		attributeResourceQuotaUsed: map[string]any{"requests.cpu": "3", "limits.memory": "6Gi"},
+		attributeResourceQuotaHard: map[string]any{"requests.cpu": "4", "limits.memory": "8Gi"},
+	}
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		reason   string
+		message  string
+		expected map[string]any
+	}{
+		{
+			name:     "quota",
+			object:   corev1.ObjectReference{Kind: "ResourceQuota", Name: "compute", Namespace: "test"},
+			reason:   "Updated",
+			message:  "Updated the quota",
+			expected: expected,
+		},
+		{
+			name:     "quota_exceeded",
+			object:   corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-5d8f", Namespace: "test"},
+			reason:   "FailedCreate",
+			message:  `Error creating: pods "web-5d8f-x7k2" is forbidden: exceeded quota: compute, requested: requests.cpu=2, used: requests.cpu=3, limited: requests.cpu=4`,
+			expected: expected,
+		},
+		{
+			name:     "unknown_quota_exceeded",
+			object:   corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-5d8f", Namespace: "test"},
+			reason:   "FailedCreate",
+			message:  `Error creating: pods "web-5d8f-x7k2" is forbidden: exceeded quota: storage, requested: requests.storage=5Gi`,
+			expected: map[string]any{},
+		},
+		{
+			name:     "unrelated",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-5d8f-x7k2", Namespace: "test"},
+			reason:   "Started",
+			message:  "Started container web",
+			expected: map[string]any{},
+		},
+	}
+	quotaAttributes := []string{attributeResourceQuotaName, attributeResourceQuotaUsed, attributeResourceQuotaHard}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.Reason = tt.reason
+			k8sEvent.Message = tt.message
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			for _, k := range quotaAttributes {
+				v, ok := attrs.Get(k)
+				expected, expectedOk := tt.expected[k]
+				require.Equal(t, expectedOk, ok, k)
+				if ok {
+					assert.Equal(t, expected, v.AsRaw(), k)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEventWithServiceNetwork(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: v1.ObjectMeta{Name: "web", Namespace: "test"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "10.96.0.12",
+			Ports:     []corev1.ServicePort{{Port: 80}, {Port: 443}},
+		},
+	}
+	headless := &corev1.Service{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "test"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports:     []corev1.ServicePort{{Port: 5432}},
+		},
+	}
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{Name: "web", Namespace: "test"},
+		Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{{IP: "10.244.1.5"}, {IP: "10.244.2.7"}},
+			Ports:     []corev1.EndpointPort{{Port: 8080}},
+		}},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Service", "Endpoints"}
+	rCfg.EmitServiceNetwork = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, service, headless, endpoints)
+
+	tests := []struct {
+		name     string
+		kind     string
+		object   string
+		expected map[string]any
+	}{
+		{
+			name:   "service",
+			kind:   "Service",
+			object: "web",
+			expected: map[string]any{
+				"network.peer.address": "10.96.0.12",
+				attributeServicePorts:  []any{int64(80), int64(443)},
+			},
+		},
+		{
+			name:     "headless_service",
+			kind:     "Service",
+			object:   "db",
+			expected: map[string]any{attributeServicePorts: []any{int64(5432)}},
+		},
+		{
+			name:   "endpoints",
+			kind:   "Endpoints",
+			object: "web",
+			expected: map[string]any{
+				attributeEndpointsAddresses: []any{"10.244.1.5", "10.244.2.7"},
+				attributeEndpointsPorts:     []any{int64(8080)},
+			},
+		},
+		{
+			name:     "missing_service",
+			kind:     "Service",
+			object:   "cache",
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = corev1.ObjectReference{Kind: tt.kind, Name: tt.object, Namespace: "test"}
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			for _, k := range []string{"network.peer.address", attributeServicePorts, attributeEndpointsAddresses, attributeEndpointsPorts} {
+				v, ok := attrs.Get(k)
+				expected, expectedOk := tt.expected[k]
+				require.Equal(t, expectedOk, ok, k)
+				if ok {
+					assert.Equal(t, expected, v.AsRaw(), k)
+				}
+			}
+		})
+	}
+}
+
+func TestContainerFromFieldPath(t *testing.T) {
+	assert.Equal(t, "app", containerFromFieldPath("spec.containers{app}"))
+	assert.Equal(t, "init", containerFromFieldPath("spec.initContainers{init}"))
+	assert.Empty(t, containerFromFieldPath(""))
+	assert.Empty(t, containerFromFieldPath("spec"))
+}
+
+func TestHandleEventWithMessageRedactions(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.MessageRedactionPatterns = []string{`password=(\S+)`}
+
+	// The receivers sharing the configuration compile the patterns concurrently.
+	var wg sync.WaitGroup
+	sinks := make([]*consumertest.LogsSink, 4)
+	for i := range sinks {
+		sinks[i] = new(consumertest.LogsSink)
+		wg.Add(1)
+		go func(sink *consumertest.LogsSink) {
+			defer wg.Done()
+			r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), rCfg, sink)
+			if !assert.NoError(t, err) {
+				return
+			}
+			recv := r.(*k8seventsReceiver)
+			recv.ctx = context.Background()
+			k8sEvent := getEvent()
+			k8sEvent.Message = "login with password=hunter2"
+			recv.handleEvent(k8sEvent)
+		}(sinks[i])
+	}
+	wg.Wait()
+	for _, sink := range sinks {
+		require.Equal(t, 1, sink.LogRecordCount())
+		body := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str()
+		assert.Equal(t, "login with password=***", body)
+	}
+}
+
+func TestHandleEventWithMatchedFilters(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "test-34bcd-rn54",
+			Namespace:         "test",
+			CreationTimestamp: v1.NewTime(time.Now().Add(-time.Hour)),
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Namespaces = []string{"test"}
+	rCfg.RequireInvolvedObject = true
+	rCfg.Enrichment.Enabled = true
+	rCfg.MinInvolvedObjectAge = time.Minute
+	rCfg.EmitMatchedFilters = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	// The namespaces are resolved when the receiver starts.
+	recv.namespaces = rCfg.Namespaces
+	recv.objectCache = newTestObjectCache(t, []string{"Pod"}, pod)
+
+	tests := []struct {
+		name     string
+		pod      string
+		expected []any
+	}{
+		{
+			name:     "cached",
+			pod:      "test-34bcd-rn54",
+			expected: []any{"namespaces", "require_involved_object", "start_time", "min_involved_object_age"},
+		},
+		{
+			name:     "not_cached",
+			pod:      "unknown-pod",
+			expected: []any{"namespaces", "require_involved_object", "start_time"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Name = tt.pod
+			k8sEvent.InvolvedObject.UID = ""
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			matched, ok := attrs.Get(attributeMatchedFilters)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, matched.Slice().AsRaw())
+		})
+	}
+}
+
+func TestHandleEventWithWorkloadSelector(t *testing.T) {
+	isController := true
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "Deployment", Name: "web", Controller: &isController},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web-5d4f8-x9z2",
+			Namespace: "test",
+			OwnerReferences: []v1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "web-5d4f8", Controller: &isController},
+			},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "ReplicaSet"}
+	rCfg.WorkloadSelector.Deployments = []string{"test/web"}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Pod", "ReplicaSet"}, rs, pod)
+
+	tests := []struct {
+		name    string
+		ref     corev1.ObjectReference
+		allowed bool
+	}{
+		{name: "deployment", ref: corev1.ObjectReference{Kind: "Deployment", Namespace: "test", Name: "web"}, allowed: true},
+		{name: "replica_set", ref: corev1.ObjectReference{Kind: "ReplicaSet", Namespace: "test", Name: "web-5d4f8"}, allowed: true},
+		{name: "pod", ref: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "web-5d4f8-x9z2"}, allowed: true},
+		{name: "other_deployment", ref: corev1.ObjectReference{Kind: "Deployment", Namespace: "test", Name: "api"}},
+		{name: "same_name_in_other_namespace", ref: corev1.ObjectReference{Kind: "Deployment", Namespace: "other", Name: "web"}},
+		{name: "not_cached_pod", ref: corev1.ObjectReference{Kind: "Pod", Namespace: "test", Name: "unknown"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.ref
+			recv.handleEvent(k8sEvent)
+			if !tt.allowed {
+				assert.Equal(t, 0, sink.LogRecordCount())
+				return
+			}
+			require.Equal(t, 1, sink.LogRecordCount())
+			name, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("k8s.deployment.name")
+			require.True(t, ok)
+			assert.Equal(t, "web", name.Str())
+		})
+	}
+}
+
+func TestHandleEventWithInvolvedObjectLabels(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+			Labels: map[string]string{
+				"app":               "web",
+				"version":           "1.4.2",
+				"pod-template-hash": "5d8f",
+			},
+		},
+	}
+	node := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"app": "infra"},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Pod", "Node"}
+	rCfg.InvolvedObjectLabelKeys = []string{"app", "version", "team"}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, pod, node)
+
+	tests := []struct {
+		name     string
+		object   corev1.ObjectReference
+		expected map[string]any
+	}{
+		{
+			name:   "pod",
+			object: getEvent().InvolvedObject,
+			expected: map[string]any{
+				"k8s.pod.label.app":     "web",
+				"k8s.pod.label.version": "1.4.2",
+			},
+		},
+		{
+			name:   "node",
+			object: corev1.ObjectReference{Kind: "Node", Name: "node-1"},
+			expected: map[string]any{
+				"k8s.node.label.app": "infra",
+			},
+		},
+		{
+			name:     "not_cached",
+			object:   corev1.ObjectReference{Kind: "Pod", Name: "web-2", Namespace: "test"},
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = tt.object
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			labels := map[string]any{}
+			for k, v := range sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().AsRaw() {
+				if strings.Contains(k, ".label.") {
+					labels[k] = v
+				}
+			}
+			assert.Equal(t, tt.expected, labels)
+		})
+	}
+}
+
+func TestHandleEventWithJSONAnnotations(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-34bcd-rn54",
+			Namespace: "test",
+			UID:       types.UID("059f3edc-b5a9"),
+			Annotations: map[string]string{
+				"example.com/config":  `{"tier": "backend", "replicas": 2}`,
+				"example.com/invalid": "not json",
+			},
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.ParseJSONAnnotations = []string{"example.com/config", "example.com/invalid"}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, pod)
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	config, ok := attrs.Get("k8s.pod.annotation.example.com/config")
+	require.True(t, ok)
+	require.Equal(t, pcommon.ValueTypeMap, config.Type())
+	assert.Equal(t, map[string]any{"tier": "backend", "replicas": float64(2)}, config.Map().AsRaw())
+	invalid, ok := attrs.Get("k8s.pod.annotation.example.com/invalid")
+	require.True(t, ok)
+	assert.Equal(t, "not json", invalid.Str())
+}
+
+func TestHandleEventWithObjectAgeAtEvent(t *testing.T) {
+	// Later than the start of the receiver, which drops the older events.
+	eventTime := time.Now().Add(time.Minute).Truncate(time.Second)
+	newPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "web-new",
+			Namespace:         "test",
+			CreationTimestamp: v1.NewTime(eventTime.Add(-5 * time.Second)),
+		},
+	}
+	oldPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "web-old",
+			Namespace:         "test",
+			CreationTimestamp: v1.NewTime(eventTime.Add(-6 * time.Hour)),
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.EmitObjectAgeAtEvent = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, newPod, oldPod)
+
+	tests := []struct {
+		name     string
+		pod      string
+		expected float64
+		cached   bool
+	}{
+		{name: "new_object", pod: "web-new", expected: 5, cached: true},
+		{name: "old_object", pod: "web-old", expected: (6 * time.Hour).Seconds(), cached: true},
+		{name: "not_cached", pod: "web-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject = corev1.ObjectReference{Kind: "Pod", Name: tt.pod, Namespace: "test"}
+			k8sEvent.EventTime = v1.NewMicroTime(eventTime)
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			age, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeObjectAgeAtEvent)
+			require.Equal(t, tt.cached, ok)
+			if tt.cached {
+				assert.Equal(t, tt.expected, age.Double())
+			}
+		})
+	}
+}
+
+func TestHandleEventWithRawObject(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:          "test-34bcd-rn54",
+			Namespace:     "test",
+			UID:           types.UID("059f3edc-b5a9"),
+			ManagedFields: []v1.ManagedFieldsEntry{{Manager: "kube-controller-manager"}},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-1"},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.EmitRawObject = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, rCfg.Enrichment.Kinds, pod)
+
+	recv.handleEvent(getEvent())
+	require.Equal(t, 1, sink.LogRecordCount())
+	raw, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeRawObject)
+	require.True(t, ok)
+	var cached corev1.Pod
+	require.NoError(t, json.Unmarshal([]byte(raw.Str()), &cached))
+	assert.Equal(t, "node-1", cached.Spec.NodeName)
+	assert.Empty(t, cached.ManagedFields)
+
+	// The attribute is omitted for the objects missing from the cache.
+	sink.Reset()
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.Name = "web-2"
+	recv.handleEvent(k8sEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+	_, ok = sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeRawObject)
+	assert.False(t, ok)
+}
+
+func TestHandleEventWithNamespaceOwner(t *testing.T) {
+	owned := &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "test",
+			Annotations: map[string]string{"owner-team": "payments"},
+		},
+	}
+	unowned := &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "unowned",
+		},
+	}
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Enrichment.Enabled = true
+	rCfg.Enrichment.Kinds = []string{"Namespace"}
+	rCfg.NamespaceOwnerAnnotation = "owner-team"
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.objectCache = newTestObjectCache(t, []string{"Namespace"}, owned, unowned)
+
+	tests := []struct {
+		name      string
+		namespace string
+		owner     string
+	}{
+		{name: "annotated", namespace: "test", owner: "payments"},
+		{name: "not_annotated", namespace: "unowned"},
+		{name: "not_cached", namespace: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink.Reset()
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Namespace = tt.namespace
+			recv.handleEvent(k8sEvent)
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+			owner, ok := attrs.Get(attributeNamespaceOwner)
+			if tt.owner == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.owner, owner.Str())
+		})
+	}
+}
+
+func TestHandleEventWithFirstOccurrenceOnly(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.FirstOccurrenceOnly.Enabled = true
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, sink)
 	require.NoError(t, err)
-	require.NotNil(t, r)
 	recv := r.(*k8seventsReceiver)
 	recv.ctx = context.Background()
-	k8sEvent := getEvent()
-	recv.handleEvent(k8sEvent)
 
-	assert.Equal(t, 1, sink.LogRecordCount())
+	backOff := getEvent()
+	backOff.Reason = "BackOff"
+	repeat := getEvent()
+	repeat.Reason = "BackOff"
+	repeat.Count = 5
+	unhealthy := getEvent()
+	unhealthy.Reason = "Unhealthy"
+	otherPod := getEvent()
+	otherPod.Reason = "BackOff"
+	otherPod.InvolvedObject.UID = types.UID("7c3b-91ea")
+	for _, ev := range []*corev1.Event{backOff, repeat, unhealthy, otherPod, repeat} {
+		recv.handleEvent(ev)
+	}
+
+	require.Equal(t, 3, sink.LogRecordCount())
+	assert.Equal(t, map[string]int64{dropReasonFirstOccurrence: 2}, recv.stats.dropped)
+	metadatatest.AssertEqualK8seventsRepeatsSuppressed(t, tel, []metricdata.DataPoint[int64]{
+		{Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestHandleEventWithDeadLetterLog(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	core, logs := observer.New(zapcore.ErrorLevel)
+	set := metadatatest.NewSettings(tel)
+	set.Logger = zap.New(core)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.DeadLetterLog.Enabled = true
+	rCfg.DeadLetterLog.MaxPerMinute = 1
+	r, err := newReceiver(set, rCfg, consumertest.NewErr(errors.New("sending queue is full")))
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+
+	assert.Equal(t, 1, logs.FilterMessage("dropping the event which failed to be consumed").Len())
+	metadatatest.AssertEqualK8seventsDeadLettered(t, tel, []metricdata.DataPoint[int64]{
+		{Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestHandleEventWithLoadShedding(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.LoadSheddingOnFull.Enabled = true
+	rCfg.LoadSheddingOnFull.Cooldown = time.Minute
+	var consumed int
+	var consumeErr error
+	next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		consumed++
+		return consumeErr
+	})
+	require.NoError(t, err)
+	r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, next)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+
+	// Errors not reporting the pipeline being at capacity don't shed the events.
+	consumeErr = errors.New("invalid log record")
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 2, consumed)
+
+	// The events are shed during the cooldown following a capacity error.
+	consumeErr = errors.New("sending queue is full")
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 3, consumed)
+	assert.Equal(t, map[string]int64{dropReasonLoadShedding: 2}, recv.stats.dropped)
+	metadatatest.AssertEqualK8seventsLoadShed(t, tel, []metricdata.DataPoint[int64]{
+		{Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+
+	// The events are consumed again once the cooldown is over.
+	consumeErr = nil
+	recv.shedder.until.Store(time.Now().Add(-time.Second).UnixNano())
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 4, consumed)
 }
 
 func TestDropEventsOlderThanStartupTime(t *testing.T) {
@@ -118,18 +2477,250 @@ func TestAllowEvent(t *testing.T) {
 	recv := r.(*k8seventsReceiver)
 	k8sEvent := getEvent()
 
-	shouldAllowEvent := recv.allowEvent(k8sEvent)
+	_, _, shouldAllowEvent := recv.allowEvent(k8sEvent)
 	assert.True(t, shouldAllowEvent)
 
 	k8sEvent.FirstTimestamp = v1.Time{Time: time.Now().Add(-time.Hour)}
-	shouldAllowEvent = recv.allowEvent(k8sEvent)
+	_, reason, shouldAllowEvent := recv.allowEvent(k8sEvent)
 	assert.False(t, shouldAllowEvent)
+	assert.Equal(t, dropReasonBeforeStart, reason)
 
 	k8sEvent.FirstTimestamp = v1.Time{}
-	shouldAllowEvent = recv.allowEvent(k8sEvent)
+	_, _, shouldAllowEvent = recv.allowEvent(k8sEvent)
 	assert.False(t, shouldAllowEvent)
 }
 
+func TestAllowEventWithRequireInvolvedObject(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject = corev1.ObjectReference{}
+	_, _, ok := recv.allowEvent(k8sEvent)
+	assert.True(t, ok)
+
+	rCfg.RequireInvolvedObject = true
+	_, reason, ok := recv.allowEvent(k8sEvent)
+	assert.False(t, ok)
+	assert.Equal(t, dropReasonNoInvolvedObject, reason)
+	_, _, ok = recv.allowEvent(getEvent())
+	assert.True(t, ok)
+}
+
+func TestAllowEventWithSuppressSelfEvents(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.SuppressSelfEvents = "otel-collector"
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+
+	selfEvent := getEvent()
+	selfEvent.ReportingController = "otel-collector"
+	_, reason, ok := recv.allowEvent(selfEvent)
+	assert.False(t, ok)
+	assert.Equal(t, dropReasonSelfEvent, reason)
+
+	// The legacy source component identifies the controller as well.
+	legacySelfEvent := getEvent()
+	legacySelfEvent.Source.Component = "otel-collector"
+	_, _, ok = recv.allowEvent(legacySelfEvent)
+	assert.False(t, ok)
+
+	_, _, ok = recv.allowEvent(getEvent())
+	assert.True(t, ok)
+}
+
+func TestAllowEventWithExcludeInvolvedObjectNames(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ExcludeInvolvedObjectNames = []string{"node-exporter-*", "canary-?"}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+
+	tests := []struct {
+		name     string
+		object   string
+		excluded bool
+	}{
+		{name: "glob_match", object: "node-exporter-x7k2p", excluded: true},
+		{name: "single_character_match", object: "canary-1", excluded: true},
+		{name: "single_character_mismatch", object: "canary-12"},
+		{name: "partial_name", object: "prod-node-exporter-x7k2p"},
+		{name: "other_name", object: "web-5d4f8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.Name = tt.object
+			_, reason, ok := recv.allowEvent(k8sEvent)
+			assert.Equal(t, !tt.excluded, ok)
+			if tt.excluded {
+				assert.Equal(t, dropReasonExcludedName, reason)
+			}
+		})
+	}
+}
+
+func TestAllowEventWithEventTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		types    []string
+		selector string
+		allowed  map[string]bool
+	}{
+		{
+			name:    "all_types",
+			allowed: map[string]bool{"Normal": true, "Warning": true},
+		},
+		{
+			name:     "single_type",
+			types:    []string{"Warning"},
+			selector: "type=Warning",
+			allowed:  map[string]bool{"Normal": false, "Warning": true},
+		},
+		{
+			name:    "several_types",
+			types:   []string{"Normal", "Warning"},
+			allowed: map[string]bool{"Normal": true, "Warning": true, "Custom": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.EventTypes = tt.types
+			recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+			assert.Equal(t, tt.selector, recv.fieldSelector())
+			for typ, allowed := range tt.allowed {
+				k8sEvent := getEvent()
+				k8sEvent.Type = typ
+				_, reason, ok := recv.allowEvent(k8sEvent)
+				assert.Equal(t, allowed, ok, typ)
+				if !allowed {
+					assert.Equal(t, dropReasonEventType, reason, typ)
+				}
+			}
+		})
+	}
+}
+
+func TestStartWithSingleEventType(t *testing.T) {
+	var mu sync.Mutex
+	var selectors []string
+	client := fake.NewClientset()
+	record := func(action k8stesting.Action) (bool, runtime.Object, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		selectors = append(selectors, action.(k8stesting.ListActionImpl).ListOptions.FieldSelector)
+		return false, nil, nil
+	}
+	client.PrependReactor("list", "events", record)
+	client.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		selectors = append(selectors, action.(k8stesting.WatchActionImpl).WatchRestrictions.Fields.String())
+		return false, nil, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EventTypes = []string{"Warning"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(selectors) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	for _, selector := range selectors {
+		assert.Equal(t, "type=Warning", selector)
+	}
+}
+
+func TestStartWithWatchScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces []string
+		expected   string
+	}{
+		{name: "cluster", expected: watchScopeCluster},
+		{name: "namespaced", namespaces: []string{"test", "other"}, expected: watchScopeNamespaced},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sEvent := getEvent()
+			k8sEvent.FirstTimestamp = v1.NewTime(time.Now().Add(time.Hour))
+			client := fake.NewClientset(k8sEvent)
+
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.Namespaces = tt.namespaces
+			rCfg.EmitWatchScope = true
+			rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+				return client, nil
+			}
+			sink := new(consumertest.LogsSink)
+			recv := newTestReceiver(t, rCfg, sink)
+			require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+			assert.Eventually(t, func() bool {
+				return sink.LogRecordCount() == 1
+			}, 5*time.Second, 10*time.Millisecond)
+			scope, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeWatchScope)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, scope.Str())
+		})
+	}
+}
+
+func TestAllowEventWithConsistentSampleRate(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ConsistentSampleRate = 0.5
+	recv := newTestReceiver(t, rCfg, consumertest.NewNop())
+
+	kept := 0
+	for i := 0; i < 1000; i++ {
+		uid := types.UID(fmt.Sprintf("uid-%d", i))
+		var allowed []bool
+		// All the events about an object share its fate, whatever their reason.
+		for _, reason := range []string{"Scheduled", "Pulled", "Started", "BackOff"} {
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.UID = uid
+			k8sEvent.Reason = reason
+			_, _, ok := recv.allowEvent(k8sEvent)
+			allowed = append(allowed, ok)
+		}
+		assert.Equal(t, []bool{allowed[0], allowed[0], allowed[0], allowed[0]}, allowed, uid)
+		if allowed[0] {
+			kept++
+		} else {
+			k8sEvent := getEvent()
+			k8sEvent.InvolvedObject.UID = uid
+			_, reason, _ := recv.allowEvent(k8sEvent)
+			assert.Equal(t, dropReasonSampled, reason)
+		}
+	}
+	assert.InDelta(t, 500, kept, 75)
+
+	// The events without an involved object UID are always kept.
+	k8sEvent := getEvent()
+	k8sEvent.InvolvedObject.UID = ""
+	_, _, ok := recv.allowEvent(k8sEvent)
+	assert.True(t, ok)
+}
+
+func newTestReceiver(t *testing.T, cfg *Config, consumer consumer.Logs) *k8seventsReceiver {
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		cfg,
+		consumer,
+	)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+	return recv
+}
+
 func getEvent() *corev1.Event {
 	return &corev1.Event{
 		InvolvedObject: corev1.ObjectReference{
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// relistFilter tells apart the events redelivered when the informer of a watch relists the events,
+// e.g. after the watch expired, by their resource version. The events listed or watched up to a
+// list are all redelivered by the next list, so the events with a resource version not greater
+// than the highest one handled before the list started are redelivered ones.
+// Resource versions are opaque per the API conventions, so the events whose resource
+// version isn't an integer, as with some API server implementations, are never filtered.
+type relistFilter struct {
+	mu        sync.Mutex
+	highest   uint64
+	threshold uint64
+}
+
+// relist records that the informer starts listing the events.
+func (f *relistFilter) relist() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.threshold = f.highest
+}
+
+// redelivered reports whether obj was already delivered before the last list, recording its resource version.
+func (f *relistFilter) redelivered(obj any) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	rv, err := strconv.ParseUint(accessor.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if rv <= f.threshold {
+		return true
+	}
+	f.highest = max(f.highest, rv)
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestRelistFilter(t *testing.T) {
+	event := func(rv string) *corev1.Event {
+		return &corev1.Event{ObjectMeta: v1.ObjectMeta{ResourceVersion: rv}}
+	}
+	filter := &relistFilter{}
+
+	// The first list isn't ordered by resource version.
+	filter.relist()
+	assert.False(t, filter.redelivered(event("7")))
+	assert.False(t, filter.redelivered(event("5")))
+	assert.False(t, filter.redelivered(event("9")))
+
+	// The relist redelivers the events handled so far, along with the updated and the new ones.
+	filter.relist()
+	assert.True(t, filter.redelivered(event("5")))
+	assert.True(t, filter.redelivered(event("9")))
+	assert.False(t, filter.redelivered(event("12")))
+	assert.False(t, filter.redelivered(event("11")))
+
+	// Opaque resource versions are never filtered.
+	assert.False(t, filter.redelivered(event("")))
+	assert.False(t, filter.redelivered(event("a1b2")))
+	assert.False(t, filter.redelivered("not an object"))
+}
+
+func TestStartWithDeduplicateRelists(t *testing.T) {
+	future := v1.NewTime(time.Now().Add(time.Hour))
+	event := func(name, rv string, count int32) corev1.Event {
+		ev := getEvent()
+		ev.Name = name
+		ev.UID = types.UID(name)
+		ev.ResourceVersion = rv
+		ev.Count = count
+		ev.FirstTimestamp = future
+		return *ev
+	}
+	lists := []*corev1.EventList{
+		{
+			ListMeta: v1.ListMeta{ResourceVersion: "10"},
+			Items:    []corev1.Event{event("b", "7", 1), event("a", "5", 1)},
+		},
+		{
+			ListMeta: v1.ListMeta{ResourceVersion: "12"},
+			Items:    []corev1.Event{event("a", "5", 1), event("b", "11", 2), event("c", "12", 1)},
+		},
+	}
+
+	var mu sync.Mutex
+	var listed, watched int
+	client := fake.NewClientset()
+	client.PrependReactor("list", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		list := lists[min(listed, len(lists)-1)]
+		listed++
+		return true, list.DeepCopy(), nil
+	})
+	client.PrependWatchReactor("events", func(k8stesting.Action) (bool, watch.Interface, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		watched++
+		w := watch.NewFakeWithChanSize(1, false)
+		if watched == 1 {
+			// Expire the first watch, so that the informer relists the events.
+			w.Error(&v1.Status{Status: v1.StatusFailure, Code: 410, Reason: v1.StatusReasonExpired})
+		}
+		return true, w, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.DeduplicateRelists = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 4
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		recv.stats.mu.Lock()
+		defer recv.stats.mu.Unlock()
+		return recv.stats.dropped[dropReasonRelisted] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var names []string
+	for _, ld := range sink.AllLogs() {
+		name, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("k8s.event.name")
+		require.True(t, ok)
+		names = append(names, name.Str())
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "b", "c"}, names)
+}
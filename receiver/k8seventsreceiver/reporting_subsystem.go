@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// attributeReportingSubsystem is the node subsystem reporting the event, i.e. the kubelet or its container runtime.
+const attributeReportingSubsystem = "k8s.event.reporting.subsystem"
+
+// reportingSubsystems maps the lower case components of the reporters to the subsystems they identify.
+// The docker engine is only reachable from the kubelet through the dockershim.
+var reportingSubsystems = map[string]string{
+	"kubelet":    "kubelet",
+	"containerd": "containerd",
+	"cri-o":      "cri-o",
+	"crio":       "cri-o",
+	"dockershim": "dockershim",
+	"docker":     "dockershim",
+}
+
+// reportingSubsystem parses the reporting controller and the source component of the events about the pods
+// and the nodes, e.g. `kubelet`, `kubernetes.io/kubelet` or `kubelet/containerd`, into the subsystem
+// reporting them. The container runtimes are preferred to the kubelet when both are named.
+func reportingSubsystem(ev *corev1.Event) (string, bool) {
+	if ev.InvolvedObject.Kind != "Pod" && ev.InvolvedObject.Kind != "Node" {
+		return "", false
+	}
+	subsystem := ""
+	for _, reporter := range []string{ev.ReportingController, ev.Source.Component} {
+		for _, component := range strings.FieldsFunc(strings.ToLower(reporter), isReporterSeparator) {
+			s, ok := reportingSubsystems[component]
+			if !ok {
+				continue
+			}
+			if s != "kubelet" {
+				return s, true
+			}
+			subsystem = s
+		}
+	}
+	return subsystem, subsystem != ""
+}
+
+func isReporterSeparator(r rune) bool {
+	switch r {
+	case '/', ',', ';', ':', ' ', '(', ')':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReportingSubsystem(t *testing.T) {
+	tests := []struct {
+		name                string
+		kind                string
+		reportingController string
+		sourceComponent     string
+		expected            string
+	}{
+		{name: "kubelet", kind: "Pod", sourceComponent: "kubelet", expected: "kubelet"},
+		{name: "reporting_controller", kind: "Pod", reportingController: "kubelet", sourceComponent: "other", expected: "kubelet"},
+		{name: "domain_prefixed", kind: "Node", reportingController: "kubernetes.io/kubelet", expected: "kubelet"},
+		{name: "containerd", kind: "Pod", sourceComponent: "containerd", expected: "containerd"},
+		{name: "kubelet_and_runtime", kind: "Pod", sourceComponent: "kubelet/containerd", expected: "containerd"},
+		{name: "runtime_in_source", kind: "Pod", reportingController: "kubelet", sourceComponent: "dockershim", expected: "dockershim"},
+		{name: "docker", kind: "Pod", sourceComponent: "Docker", expected: "dockershim"},
+		{name: "crio", kind: "Node", sourceComponent: "crio", expected: "cri-o"},
+		{name: "unknown_reporter", kind: "Pod", sourceComponent: "default-scheduler"},
+		{name: "no_reporter", kind: "Pod"},
+		{name: "other_kind", kind: "Deployment", sourceComponent: "kubelet"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subsystem, ok := reportingSubsystem(&corev1.Event{
+				InvolvedObject:      corev1.ObjectReference{Kind: tt.kind},
+				ReportingController: tt.reportingController,
+				Source:              corev1.EventSource{Component: tt.sourceComponent},
+			})
+			assert.Equal(t, tt.expected != "", ok)
+			assert.Equal(t, tt.expected, subsystem)
+		})
+	}
+}
+
+func TestK8sEventToLogDataWithReportingSubsystem(t *testing.T) {
+	k8sEvent := getEvent()
+	k8sEvent.Source.Component = "kubelet"
+	cfg := createDefaultConfig().(*Config)
+
+	lr := k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, ok := lr.Attributes().Get(attributeReportingSubsystem)
+	assert.False(t, ok)
+
+	cfg.EmitReportingSubsystem = true
+	lr = k8sEventToLogData(zap.NewNop(), k8sEvent, cfg, nil).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	subsystem, ok := lr.Attributes().Get(attributeReportingSubsystem)
+	require.True(t, ok)
+	assert.Equal(t, "kubelet", subsystem.Str())
+}
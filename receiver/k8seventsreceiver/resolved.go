@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// attributeResolved flags the synthetic logs reporting that a Warning event cleared.
+	attributeResolved = "k8s.event.resolved"
+
+	// attributeResolvedBy is how the Warning event cleared, one of the resolvedBy values.
+	attributeResolvedBy = "k8s.event.resolved.by"
+
+	// resolvedByNormalEvent resolves the Warning events of an object on a Normal event about the object.
+	resolvedByNormalEvent = "normal_event"
+
+	// resolvedByQuietPeriod resolves the Warning events not recurring during the quiet period.
+	resolvedByQuietPeriod = "quiet_period"
+)
+
+// warningTracker tracks the active Warning events by object and reason, until they are resolved by a
+// Normal event about the same object or by not recurring during the quiet period. The warnings of at
+// most maxEntries objects and reasons are tracked, the least recent ones being evicted first.
+type warningTracker struct {
+	quietPeriod time.Duration
+	maxEntries  int
+
+	mu       sync.Mutex
+	warnings map[types.UID]map[string]*list.Element
+	order    *list.List
+}
+
+// activeWarning is the latest occurrence of a Warning event not resolved yet.
+type activeWarning struct {
+	uid    types.UID
+	reason string
+	event  *corev1.Event
+	last   time.Time
+}
+
+func newWarningTracker(quietPeriod time.Duration, maxEntries int) *warningTracker {
+	return &warningTracker{
+		quietPeriod: quietPeriod,
+		maxEntries:  maxEntries,
+		warnings:    make(map[types.UID]map[string]*list.Element),
+		order:       list.New(),
+	}
+}
+
+// observe tracks the Warning event ev, or returns the Warning events it resolves if it is a Normal event.
+// The events without an involved object UID are ignored.
+func (t *warningTracker) observe(ev *corev1.Event) []*corev1.Event {
+	uid := ev.InvolvedObject.UID
+	if uid == "" {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch ev.Type {
+	case corev1.EventTypeWarning:
+		_, last := eventSeen(ev)
+		if elem, ok := t.warnings[uid][ev.Reason]; ok {
+			w := elem.Value.(*activeWarning)
+			w.event = ev.DeepCopy()
+			w.last = last
+			t.order.MoveToBack(elem)
+			return nil
+		}
+		if t.order.Len() >= t.maxEntries {
+			t.remove(t.order.Front())
+		}
+		reasons, ok := t.warnings[uid]
+		if !ok {
+			reasons = make(map[string]*list.Element)
+			t.warnings[uid] = reasons
+		}
+		reasons[ev.Reason] = t.order.PushBack(&activeWarning{uid: uid, reason: ev.Reason, event: ev.DeepCopy(), last: last})
+		return nil
+	case corev1.EventTypeNormal:
+		reasons := t.warnings[uid]
+		resolved := make([]*corev1.Event, 0, len(reasons))
+		for _, elem := range reasons {
+			resolved = append(resolved, elem.Value.(*activeWarning).event)
+			t.remove(elem)
+		}
+		return resolved
+	default:
+		return nil
+	}
+}
+
+// expire returns the Warning events that didn't recur during the quiet period before now, no longer tracking them.
+func (t *warningTracker) expire(now time.Time) []*corev1.Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var resolved []*corev1.Event
+	for elem := t.order.Front(); elem != nil; {
+		next := elem.Next()
+		if w := elem.Value.(*activeWarning); now.Sub(w.last) >= t.quietPeriod {
+			resolved = append(resolved, w.event)
+			t.remove(elem)
+		}
+		elem = next
+	}
+	return resolved
+}
+
+// remove stops tracking the warning of elem.
+func (t *warningTracker) remove(elem *list.Element) {
+	w := t.order.Remove(elem).(*activeWarning)
+	delete(t.warnings[w.uid], w.reason)
+	if len(t.warnings[w.uid]) == 0 {
+		delete(t.warnings, w.uid)
+	}
+}
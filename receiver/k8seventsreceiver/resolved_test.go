@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func newWarningEvent(uid types.UID, reason string, last time.Time) *corev1.Event {
+	ev := getEvent()
+	ev.InvolvedObject.UID = uid
+	ev.Type = corev1.EventTypeWarning
+	ev.Reason = reason
+	ev.LastTimestamp = v1.NewTime(last)
+	return ev
+}
+
+func newNormalEvent(uid types.UID, reason string) *corev1.Event {
+	ev := getEvent()
+	ev.InvolvedObject.UID = uid
+	ev.Type = corev1.EventTypeNormal
+	ev.Reason = reason
+	return ev
+}
+
+func reasons(events []*corev1.Event) []string {
+	var reasons []string
+	for _, ev := range events {
+		reasons = append(reasons, ev.Reason)
+	}
+	return reasons
+}
+
+func TestWarningTrackerResolvedByNormalEvent(t *testing.T) {
+	now := time.Now()
+	tracker := newWarningTracker(time.Hour, 10)
+	assert.Empty(t, tracker.observe(newWarningEvent("pod-1", "BackOff", now)))
+	assert.Empty(t, tracker.observe(newWarningEvent("pod-1", "Unhealthy", now)))
+	assert.Empty(t, tracker.observe(newWarningEvent("pod-2", "BackOff", now)))
+	// A recurrence doesn't open another warning.
+	assert.Empty(t, tracker.observe(newWarningEvent("pod-1", "BackOff", now.Add(time.Minute))))
+
+	assert.ElementsMatch(t, []string{"BackOff", "Unhealthy"}, reasons(tracker.observe(newNormalEvent("pod-1", "Started"))))
+	assert.Empty(t, tracker.observe(newNormalEvent("pod-1", "Started")))
+	assert.Equal(t, 1, tracker.order.Len())
+
+	// The events without an involved object UID are ignored.
+	assert.Empty(t, tracker.observe(newWarningEvent("", "BackOff", now)))
+	assert.Equal(t, 1, tracker.order.Len())
+}
+
+func TestWarningTrackerResolvedByQuietPeriod(t *testing.T) {
+	now := time.Now()
+	tracker := newWarningTracker(10*time.Minute, 10)
+	tracker.observe(newWarningEvent("pod-1", "BackOff", now))
+	tracker.observe(newWarningEvent("pod-2", "BackOff", now))
+	tracker.observe(newWarningEvent("pod-2", "BackOff", now.Add(5*time.Minute)))
+
+	assert.Empty(t, tracker.expire(now.Add(9*time.Minute)))
+	resolved := tracker.expire(now.Add(10 * time.Minute))
+	require.Len(t, resolved, 1)
+	assert.Equal(t, types.UID("pod-1"), resolved[0].InvolvedObject.UID)
+	resolved = tracker.expire(now.Add(15 * time.Minute))
+	require.Len(t, resolved, 1)
+	assert.Equal(t, types.UID("pod-2"), resolved[0].InvolvedObject.UID)
+	assert.Empty(t, tracker.warnings)
+	assert.Equal(t, 0, tracker.order.Len())
+}
+
+func TestWarningTrackerEviction(t *testing.T) {
+	now := time.Now()
+	tracker := newWarningTracker(time.Hour, 2)
+	tracker.observe(newWarningEvent("pod-1", "BackOff", now))
+	tracker.observe(newWarningEvent("pod-2", "BackOff", now.Add(time.Minute)))
+	tracker.observe(newWarningEvent("pod-2", "Unhealthy", now.Add(2*time.Minute)))
+
+	// The least recent warning is evicted.
+	assert.Equal(t, 2, tracker.order.Len())
+	assert.Empty(t, tracker.observe(newNormalEvent("pod-1", "Started")))
+	assert.ElementsMatch(t, []string{"BackOff", "Unhealthy"}, reasons(tracker.observe(newNormalEvent("pod-2", "Started"))))
+
+	// A recurrence makes the warning the most recent one.
+	tracker = newWarningTracker(time.Hour, 2)
+	tracker.observe(newWarningEvent("pod-1", "BackOff", now))
+	tracker.observe(newWarningEvent("pod-2", "BackOff", now.Add(time.Minute)))
+	tracker.observe(newWarningEvent("pod-1", "BackOff", now.Add(2*time.Minute)))
+	tracker.observe(newWarningEvent("pod-3", "BackOff", now.Add(3*time.Minute)))
+	assert.Empty(t, tracker.observe(newNormalEvent("pod-2", "Started")))
+	assert.Len(t, tracker.observe(newNormalEvent("pod-1", "Started")), 1)
+}
+
+func resolvedRecords(sink *consumertest.LogsSink) []plog.LogRecord {
+	var records []plog.LogRecord
+	for _, ld := range sink.AllLogs() {
+		lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		if _, ok := lr.Attributes().Get(attributeResolved); ok {
+			records = append(records, lr)
+		}
+	}
+	return records
+}
+
+func TestHandleEventWithResolvedEvents(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ResolvedEvents.Enabled = true
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.startTime = time.Now().Add(-time.Hour)
+
+	warning := newWarningEvent("059f3edc-b5a9", "BackOff", time.Now())
+	warning.UID = "7c3b-91ea"
+	warning.Message = "Back-off restarting failed container"
+	recv.handleEvent(warning)
+	recv.handleEvent(newNormalEvent("059f3edc-b5a9", "Started"))
+
+	require.Equal(t, 3, sink.LogRecordCount())
+	records := resolvedRecords(sink)
+	require.Len(t, records, 1)
+	lr := records[0]
+	resolved, ok := lr.Attributes().Get(attributeResolved)
+	require.True(t, ok)
+	assert.True(t, resolved.Bool())
+	resolvedBy, ok := lr.Attributes().Get(attributeResolvedBy)
+	require.True(t, ok)
+	assert.Equal(t, resolvedByNormalEvent, resolvedBy.Str())
+	uid, ok := lr.Attributes().Get("k8s.event.uid")
+	require.True(t, ok)
+	assert.Equal(t, "7c3b-91ea", uid.Str())
+	reason, ok := lr.Attributes().Get("k8s.event.reason")
+	require.True(t, ok)
+	assert.Equal(t, "BackOff", reason.Str())
+	assert.Equal(t, "Back-off restarting failed container", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+	assert.Equal(t, "Normal", lr.SeverityText())
+}
+
+func TestHandleEventWithResolvedEventsFinished(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ResolvedEvents.Enabled = true
+	rCfg.Routing = RoutingConfig{Attribute: "route", Routes: map[string]string{"Normal": "info", "Warning": "alerts"}}
+	rCfg.SeverityText = SeverityTextConfig{Enabled: true, Types: map[string]string{"Normal": "NOTICE", "Warning": "WARNING"}}
+	rCfg.AttributeLimits = AttributeLimitsConfig{MaxAttributes: 4, Priority: []string{attributeResolved, attributeResolvedBy}}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.startTime = time.Now().Add(-time.Hour)
+
+	recv.handleEvent(newWarningEvent("059f3edc-b5a9", "BackOff", time.Now()))
+	recv.handleEvent(newNormalEvent("059f3edc-b5a9", "Started"))
+
+	var resolved plog.Logs
+	for _, ld := range sink.AllLogs() {
+		if _, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeResolved); ok {
+			resolved = ld
+		}
+	}
+	require.NotZero(t, resolved.LogRecordCount())
+	// The resolved log is routed, mapped and trimmed as a Normal event.
+	route, ok := resolved.ResourceLogs().At(0).Resource().Attributes().Get("route")
+	require.True(t, ok)
+	assert.Equal(t, "info", route.Str())
+	lr := resolved.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "NOTICE", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo2, lr.SeverityNumber())
+	assert.Equal(t, 4, lr.Attributes().Len())
+	trimmed, ok := lr.Attributes().Get(attributeAttributesTrimmed)
+	require.True(t, ok)
+	assert.True(t, trimmed.Bool())
+	_, ok = lr.Attributes().Get(attributeResolvedBy)
+	assert.True(t, ok)
+}
+
+func TestStartWithResolvedEventsQuietPeriod(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ResolvedEvents.Enabled = true
+	rCfg.ResolvedEvents.QuietPeriod = 50 * time.Millisecond
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	recv.handleEvent(newWarningEvent("059f3edc-b5a9", "BackOff", time.Now()))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	records := resolvedRecords(sink)
+	require.Len(t, records, 1)
+	resolvedBy, ok := records[0].Attributes().Get(attributeResolvedBy)
+	require.True(t, ok)
+	assert.Equal(t, resolvedByQuietPeriod, resolvedBy.Str())
+}
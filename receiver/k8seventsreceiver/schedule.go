@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// activeSchedule is the compiled active schedule.
+type activeSchedule struct {
+	location *time.Location
+	ranges   []dailyRange
+}
+
+// dailyRange is a compiled schedule range, with the times of the day in minutes.
+type dailyRange struct {
+	days       []time.Weekday
+	start, end int
+}
+
+// compile parses the schedule, returning nil if there are no ranges.
+func (cfg *ActiveScheduleConfig) compile() (*activeSchedule, error) {
+	if len(cfg.Ranges) == 0 {
+		return nil, nil
+	}
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+	}
+	schedule := &activeSchedule{location: location}
+	for i, r := range cfg.Ranges {
+		compiled, err := r.compile()
+		if err != nil {
+			return nil, fmt.Errorf("range %d: %w", i, err)
+		}
+		schedule.ranges = append(schedule.ranges, compiled)
+	}
+	return schedule, nil
+}
+
+func (r *ScheduleRange) compile() (dailyRange, error) {
+	if len(r.Days) == 0 {
+		return dailyRange{}, errors.New("days must not be empty")
+	}
+	var compiled dailyRange
+	for _, day := range r.Days {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return dailyRange{}, fmt.Errorf("invalid day %q", day)
+		}
+		compiled.days = append(compiled.days, weekday)
+	}
+	var ok bool
+	if compiled.start, ok = parseTimeOfDay(r.Start); !ok {
+		return dailyRange{}, fmt.Errorf(`invalid start %q, must be formatted as "HH:MM"`, r.Start)
+	}
+	if compiled.end, ok = parseTimeOfDay(r.End); !ok {
+		return dailyRange{}, fmt.Errorf(`invalid end %q, must be formatted as "HH:MM"`, r.End)
+	}
+	if compiled.start == compiled.end {
+		return dailyRange{}, errors.New("end must differ from start")
+	}
+	return compiled, nil
+}
+
+// parseWeekday parses the English name of a day of the week, case insensitively.
+func parseWeekday(day string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(day, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseTimeOfDay parses a time of the day as "15:04", returning the minutes since midnight.
+func parseTimeOfDay(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// contains reports whether t falls into any of the ranges of the schedule.
+func (s *activeSchedule) contains(t time.Time) bool {
+	t = t.In(s.location)
+	minutes := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+	previousDay := (day + 6) % 7
+	for _, r := range s.ranges {
+		switch {
+		case r.start < r.end:
+			if slices.Contains(r.days, day) && minutes >= r.start && minutes < r.end {
+				return true
+			}
+		case slices.Contains(r.days, day) && minutes >= r.start:
+			return true
+		case slices.Contains(r.days, previousDay) && minutes < r.end:
+			return true
+		}
+	}
+	return false
+}
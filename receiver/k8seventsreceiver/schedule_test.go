@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestActiveSchedule(t *testing.T) {
+	cfg := ActiveScheduleConfig{
+		Timezone: "Europe/Paris",
+		Ranges: []ScheduleRange{
+			{Days: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}, Start: "09:00", End: "18:00"},
+			// The night shift of Friday ends on Saturday.
+			{Days: []string{"friday"}, Start: "22:00", End: "02:00"},
+		},
+	}
+	schedule, err := cfg.compile()
+	require.NoError(t, err)
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected bool
+	}{
+		{name: "business_hours", time: time.Date(2025, time.March, 5, 10, 30, 0, 0, paris), expected: true},
+		{name: "start_inclusive", time: time.Date(2025, time.March, 5, 9, 0, 0, 0, paris), expected: true},
+		{name: "end_exclusive", time: time.Date(2025, time.March, 5, 18, 0, 0, 0, paris), expected: false},
+		{name: "utc_in_business_hours", time: time.Date(2025, time.March, 5, 8, 30, 0, 0, time.UTC), expected: true},
+		{name: "utc_before_business_hours", time: time.Date(2025, time.March, 5, 7, 30, 0, 0, time.UTC), expected: false},
+		{name: "weekend", time: time.Date(2025, time.March, 8, 10, 30, 0, 0, paris), expected: false},
+		{name: "night_shift_before_midnight", time: time.Date(2025, time.March, 7, 23, 0, 0, 0, paris), expected: true},
+		{name: "night_shift_after_midnight", time: time.Date(2025, time.March, 8, 1, 59, 0, 0, paris), expected: true},
+		{name: "after_night_shift", time: time.Date(2025, time.March, 8, 2, 0, 0, 0, paris), expected: false},
+		{name: "thursday_night", time: time.Date(2025, time.March, 7, 1, 0, 0, 0, paris), expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, schedule.contains(tt.time))
+		})
+	}
+}
+
+func TestActiveScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         ActiveScheduleConfig
+		expectedErr string
+	}{
+		{
+			name: "invalid_timezone",
+			cfg: ActiveScheduleConfig{
+				Timezone: "Mars/Olympus",
+				Ranges:   []ScheduleRange{{Days: []string{"Monday"}, Start: "09:00", End: "18:00"}},
+			},
+			expectedErr: `invalid timezone "Mars/Olympus": unknown time zone Mars/Olympus`,
+		},
+		{
+			name:        "invalid_day",
+			cfg:         ActiveScheduleConfig{Ranges: []ScheduleRange{{Days: []string{"Mon"}, Start: "09:00", End: "18:00"}}},
+			expectedErr: `range 0: invalid day "Mon"`,
+		},
+		{
+			name:        "no_days",
+			cfg:         ActiveScheduleConfig{Ranges: []ScheduleRange{{Start: "09:00", End: "18:00"}}},
+			expectedErr: "range 0: days must not be empty",
+		},
+		{
+			name:        "invalid_start",
+			cfg:         ActiveScheduleConfig{Ranges: []ScheduleRange{{Days: []string{"Monday"}, Start: "25:00", End: "18:00"}}},
+			expectedErr: `range 0: invalid start "25:00", must be formatted as "HH:MM"`,
+		},
+		{
+			name:        "empty_range",
+			cfg:         ActiveScheduleConfig{Ranges: []ScheduleRange{{Days: []string{"Monday"}, Start: "09:00", End: "09:00"}}},
+			expectedErr: "range 0: end must differ from start",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
+
+func TestHandleEventWithActiveSchedule(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.ActiveSchedule.Ranges = []ScheduleRange{{Days: []string{"Wednesday"}, Start: "09:00", End: "18:00"}}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	recv.startTime = time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	inSchedule := getEvent()
+	inSchedule.FirstTimestamp = v1.NewTime(time.Date(2025, time.March, 5, 10, 0, 0, 0, time.UTC))
+	outOfSchedule := getEvent()
+	outOfSchedule.FirstTimestamp = v1.NewTime(time.Date(2025, time.March, 5, 20, 0, 0, 0, time.UTC))
+	recv.handleEvent(inSchedule)
+	recv.handleEvent(outOfSchedule)
+
+	assert.Equal(t, 1, sink.LogRecordCount())
+	assert.Equal(t, map[string]int64{dropReasonActiveSchedule: 1}, recv.stats.dropped)
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// attributeStreamName is the name of the index or the stream the event is routed to by the exporters.
+const attributeStreamName = "k8s.event.stream_name"
+
+// streamNameFields are the fields the stream name template can be expanded with.
+// The namespace of the cluster scoped objects is the one of the event, and the cluster
+// is the `k8s.cluster.name` resource attribute, e.g. set through the `lookup_file`.
+var streamNameFields = map[string]func(ev *corev1.Event, resource pcommon.Map) string{
+	"namespace": func(ev *corev1.Event, _ pcommon.Map) string {
+		if ev.InvolvedObject.Namespace != "" {
+			return ev.InvolvedObject.Namespace
+		}
+		return ev.Namespace
+	},
+	"cluster": func(_ *corev1.Event, resource pcommon.Map) string {
+		if v, ok := resource.Get(semconv.AttributeK8SClusterName); ok {
+			return v.AsString()
+		}
+		return ""
+	},
+}
+
+// validateStreamNameTemplate checks that tmpl only refers to known fields.
+func validateStreamNameTemplate(tmpl string) error {
+	_, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		_, ok := streamNameFields[name]
+		return "", ok
+	})
+	return err
+}
+
+// streamName builds the stream name of ev from tmpl, with the fields sanitized
+// to be safely used in the names of the indices and the streams of the backends.
+func streamName(tmpl string, ev *corev1.Event, resource pcommon.Map) (string, bool) {
+	name, err := expandBodyTemplate(tmpl, func(name string) (string, bool) {
+		f, ok := streamNameFields[name]
+		if !ok {
+			return "", false
+		}
+		return sanitizeStreamName(f(ev, resource)), true
+	})
+	if err != nil {
+		// The template is validated, this only happens for configurations built in code.
+		return "", false
+	}
+	return name, true
+}
+
+// sanitizeStreamName lowercases s and replaces the characters other than the
+// ASCII letters, the digits, the dots, the dashes and the underscores with dashes,
+// as the indices of Elasticsearch must be lowercase and forbid most punctuation.
+func sanitizeStreamName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return '-'
+		}
+	}, s)
+}
+
+// putStreamName sets the stream name built from tmpl on the log records of ld.
+func putStreamName(ld plog.Logs, tmpl string, ev *corev1.Event) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		name, ok := streamName(tmpl, ev, rl.Resource().Attributes())
+		if !ok {
+			continue
+		}
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lrs.At(k).Attributes().PutStr(attributeStreamName, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	semconv "go.opentelemetry.io/collector/semconv/v1.27.0"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestStreamName(t *testing.T) {
+	clusterResource := pcommon.NewMap()
+	clusterResource.PutStr(semconv.AttributeK8SClusterName, "prod-eu")
+
+	tests := []struct {
+		name     string
+		tmpl     string
+		modify   func(ev *corev1.Event)
+		resource pcommon.Map
+		expected string
+	}{
+		{
+			name:     "namespace",
+			tmpl:     "k8s-events-{namespace}",
+			resource: pcommon.NewMap(),
+			expected: "k8s-events-test",
+		},
+		{
+			name:     "cluster_and_namespace",
+			tmpl:     "k8s-events-{cluster}-{namespace}",
+			resource: clusterResource,
+			expected: "k8s-events-prod-eu-test",
+		},
+		{
+			name:     "without_cluster",
+			tmpl:     "k8s-events-{cluster}-{namespace}",
+			resource: pcommon.NewMap(),
+			expected: "k8s-events--test",
+		},
+		{
+			name: "cluster_scoped",
+			tmpl: "k8s-events-{namespace}",
+			modify: func(ev *corev1.Event) {
+				ev.Namespace = "default"
+				ev.InvolvedObject = corev1.ObjectReference{Kind: "Node", Name: "node-1"}
+			},
+			resource: pcommon.NewMap(),
+			expected: "k8s-events-default",
+		},
+		{
+			name: "unsafe_characters",
+			tmpl: "k8s-events-{cluster}",
+			resource: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr(semconv.AttributeK8SClusterName, `Prod EU/1*"#`)
+				return m
+			}(),
+			expected: "k8s-events-prod-eu-1---",
+		},
+		{
+			name:     "literal",
+			tmpl:     "k8s-events",
+			resource: pcommon.NewMap(),
+			expected: "k8s-events",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, validateStreamNameTemplate(tt.tmpl))
+			k8sEvent := getEvent()
+			if tt.modify != nil {
+				tt.modify(k8sEvent)
+			}
+			name, ok := streamName(tt.tmpl, k8sEvent, tt.resource)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestPutStreamName(t *testing.T) {
+	k8sEvent := getEvent()
+	ld := k8sEventToLogData(zap.NewNop(), k8sEvent, createDefaultConfig().(*Config), nil)
+	ld.ResourceLogs().At(0).Resource().Attributes().PutStr(semconv.AttributeK8SClusterName, "prod")
+	putStreamName(ld, "k8s-events-{cluster}-{namespace}", k8sEvent)
+
+	name, ok := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(attributeStreamName)
+	require.True(t, ok)
+	assert.Equal(t, "k8s-events-prod-test", name.Str())
+}
+
+func TestValidateStreamNameTemplate(t *testing.T) {
+	assert.EqualError(t, validateStreamNameTemplate("k8s-events-{name}"), `unknown field "name"`)
+}
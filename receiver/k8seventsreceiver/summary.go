@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	// attributeSummaryProcessed is the number of events emitted during the lifetime of the receiver.
+	attributeSummaryProcessed = "k8s.event.summary.processed"
+
+	// attributeSummaryDropped holds the number of events dropped during the lifetime of the receiver, by reason.
+	attributeSummaryDropped = "k8s.event.summary.dropped"
+
+	// attributeSummaryUptime is the lifetime of the receiver in seconds.
+	attributeSummaryUptime = "k8s.event.summary.uptime_seconds"
+
+	// attributeDropDigestDropped holds the number of events dropped over the interval of a drop digest, by reason.
+	attributeDropDigestDropped = "k8s.event.drop_digest.dropped"
+
+	// attributeDropDigestTotal is the number of events dropped over the interval of a drop digest.
+	attributeDropDigestTotal = "k8s.event.drop_digest.total"
+
+	// attributeDropDigestIntervalStart is the start of the interval of a drop digest.
+	attributeDropDigestIntervalStart = "k8s.event.drop_digest.interval_start"
+)
+
+// Reasons events are dropped for, as reported in the shutdown summary.
+const (
+	dropReasonNoInvolvedObject          = "require_involved_object"
+	dropReasonSelfEvent                 = "suppress_self_events"
+	dropReasonSuppressionConfigMap      = "suppression_config_map"
+	dropReasonExcludedName              = "exclude_involved_object_names"
+	dropReasonEventType                 = "event_types"
+	dropReasonRelisted                  = "deduplicate_relists"
+	dropReasonSampled                   = "consistent_sample_rate"
+	dropReasonBeforeStart               = "start_time"
+	dropReasonEnrichment                = "enrichment"
+	dropReasonMinInvolvedObjectAge      = "min_involved_object_age"
+	dropReasonInvolvedObjectAnnotations = "involved_object_annotation_selector"
+	dropReasonWorkloadSelector          = "workload_selector"
+	dropReasonMaintenance               = "maintenance"
+	dropReasonActiveSchedule            = "active_schedule"
+	dropReasonCountGrowthRate           = "min_count_growth_rate"
+	dropReasonFirstOccurrence           = "first_occurrence_only"
+	dropReasonLoadShedding              = "load_shedding_on_full"
+	dropReasonAdaptiveThrottle          = "adaptive_throttle"
+)
+
+// eventStats counts the events handled during the lifetime of the receiver, and the events
+// dropped since the last drop digest. The events of all the watched namespaces are counted concurrently.
+type eventStats struct {
+	mu        sync.Mutex
+	processed int64
+	dropped   map[string]int64
+
+	intervalStart   time.Time
+	intervalDropped map[string]int64
+}
+
+func (s *eventStats) recordProcessed() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.processed++
+}
+
+func (s *eventStats) recordDropped(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dropped == nil {
+		s.dropped = make(map[string]int64)
+	}
+	s.dropped[reason]++
+	if s.intervalDropped == nil {
+		s.intervalDropped = make(map[string]int64)
+	}
+	s.intervalDropped[reason]++
+}
+
+// summaryLogData builds the summary log of the events handled since startTime.
+func (s *eventStats) summaryLogData(startTime, now time.Time) plog.Logs {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	lr.Body().SetStr("Kubernetes events receiver summary")
+
+	attrs := lr.Attributes()
+	attrs.PutInt(attributeSummaryProcessed, s.processed)
+	dropped := attrs.PutEmptyMap(attributeSummaryDropped)
+	dropped.EnsureCapacity(len(s.dropped))
+	for reason, count := range s.dropped {
+		dropped.PutInt(reason, count)
+	}
+	attrs.PutDouble(attributeSummaryUptime, now.Sub(startTime).Seconds())
+	return ld
+}
+
+// dropDigestLogData builds the digest log of the events dropped over the interval ending at now,
+// and resets the counts for the next interval. ok is false when no events were dropped.
+func (s *eventStats) dropDigestLogData(now time.Time) (ld plog.Logs, ok bool) {
+	s.mu.Lock()
+	dropped, start := s.intervalDropped, s.intervalStart
+	s.intervalDropped, s.intervalStart = nil, now
+	s.mu.Unlock()
+	if len(dropped) == 0 {
+		return plog.Logs{}, false
+	}
+
+	ld = plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	lr.Body().SetStr("Kubernetes events dropped digest")
+
+	attrs := lr.Attributes()
+	m := attrs.PutEmptyMap(attributeDropDigestDropped)
+	m.EnsureCapacity(len(dropped))
+	var total int64
+	for reason, count := range dropped {
+		m.PutInt(reason, count)
+		total += count
+	}
+	attrs.PutInt(attributeDropDigestTotal, total)
+	attrs.PutStr(attributeDropDigestIntervalStart, start.UTC().Format(time.RFC3339))
+	return ld, true
+}
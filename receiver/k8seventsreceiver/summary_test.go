@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestEventStatsSummaryLogData(t *testing.T) {
+	var stats eventStats
+	stats.recordProcessed()
+	stats.recordProcessed()
+	stats.recordDropped(dropReasonBeforeStart)
+	stats.recordDropped(dropReasonMaintenance)
+	stats.recordDropped(dropReasonMaintenance)
+
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ld := stats.summaryLogData(start, start.Add(90*time.Second))
+	require.Equal(t, 1, ld.LogRecordCount())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "INFO", lr.SeverityText())
+	assert.Equal(t, map[string]any{
+		attributeSummaryProcessed: int64(2),
+		attributeSummaryDropped: map[string]any{
+			dropReasonBeforeStart: int64(1),
+			dropReasonMaintenance: int64(2),
+		},
+		attributeSummaryUptime: float64(90),
+	}, lr.Attributes().AsRaw())
+}
+
+func TestEventStatsDropDigestLogData(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	stats := eventStats{intervalStart: start}
+	_, ok := stats.dropDigestLogData(start.Add(time.Minute))
+	assert.False(t, ok)
+
+	stats.recordProcessed()
+	stats.recordDropped(dropReasonEventType)
+	stats.recordDropped(dropReasonEventType)
+	stats.recordDropped(dropReasonAdaptiveThrottle)
+	ld, ok := stats.dropDigestLogData(start.Add(2 * time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 1, ld.LogRecordCount())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "INFO", lr.SeverityText())
+	assert.Equal(t, map[string]any{
+		attributeDropDigestDropped: map[string]any{
+			dropReasonEventType:        int64(2),
+			dropReasonAdaptiveThrottle: int64(1),
+		},
+		attributeDropDigestTotal:         int64(3),
+		attributeDropDigestIntervalStart: "2025-01-01T00:01:00Z",
+	}, lr.Attributes().AsRaw())
+
+	// The counts are reset for the next interval, unlike the ones of the summary.
+	stats.recordDropped(dropReasonBeforeStart)
+	ld, ok = stats.dropDigestLogData(start.Add(3 * time.Minute))
+	require.True(t, ok)
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]any{
+		attributeDropDigestDropped:       map[string]any{dropReasonBeforeStart: int64(1)},
+		attributeDropDigestTotal:         int64(1),
+		attributeDropDigestIntervalStart: "2025-01-01T00:02:00Z",
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]int64{
+		dropReasonEventType:        2,
+		dropReasonAdaptiveThrottle: 1,
+		dropReasonBeforeStart:      1,
+	}, stats.dropped)
+}
+
+func TestDropDigestEmission(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.DropDigest.Enabled = true
+	rCfg.DropDigest.Interval = 50 * time.Millisecond
+	rCfg.EventTypes = []string{"Warning"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	// The events are dropped before the start, so that they fall in the first interval.
+	recv.handleEvent(getEvent())
+	recv.handleEvent(getEvent())
+	oldEvent := getEvent()
+	oldEvent.Type = "Warning"
+	oldEvent.FirstTimestamp = v1.NewTime(time.Now().Add(-time.Hour))
+	recv.handleEvent(oldEvent)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, map[string]any{
+		dropReasonEventType:   int64(2),
+		dropReasonBeforeStart: int64(1),
+	}, attrs[attributeDropDigestDropped])
+	assert.Equal(t, int64(3), attrs[attributeDropDigestTotal])
+
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	// The events dropped since the last digest are emitted on shutdown, along with the emitted events.
+	rCfg.DropDigest.Interval = time.Hour
+	sink.Reset()
+	recv = newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	recv.handleEvent(getEvent())
+	warning := getEvent()
+	warning.Type = "Warning"
+	recv.handleEvent(warning)
+	require.Equal(t, 1, sink.LogRecordCount())
+	require.NoError(t, recv.Shutdown(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	attrs = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, map[string]any{dropReasonEventType: int64(1)}, attrs[attributeDropDigestDropped])
+	assert.Equal(t, int64(1), attrs[attributeDropDigestTotal])
+}
+
+func TestShutdownSummary(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.EmitShutdownSummary = true
+	rCfg.RequireInvolvedObject = true
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+
+	recv.handleEvent(getEvent())
+	oldEvent := getEvent()
+	oldEvent.FirstTimestamp = v1.NewTime(time.Now().Add(-time.Hour))
+	recv.handleEvent(oldEvent)
+	orphanEvent := getEvent()
+	orphanEvent.InvolvedObject = corev1.ObjectReference{}
+	recv.handleEvent(orphanEvent)
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	require.NoError(t, recv.Shutdown(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	attrs := sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	processed, ok := attrs.Get(attributeSummaryProcessed)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), processed.Int())
+	dropped, ok := attrs.Get(attributeSummaryDropped)
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{
+		dropReasonBeforeStart:      int64(1),
+		dropReasonNoInvolvedObject: int64(1),
+	}, dropped.Map().AsRaw())
+}
+
+func TestShutdownWithoutSummary(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, recv.Shutdown(context.Background()))
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// suppressionKeyReasons is the key of the ConfigMap listing the suppressed reasons, one per line.
+	suppressionKeyReasons = "reasons"
+	// suppressionKeyObjects is the key of the ConfigMap listing the names of the suppressed involved objects,
+	// one per line, either alone or prefixed with their namespace, e.g. `payments/web`.
+	suppressionKeyObjects = "objects"
+)
+
+// suppressionList holds the reasons and the involved objects whose events are suppressed,
+// updated at runtime from the watched ConfigMap.
+type suppressionList struct {
+	logger *zap.Logger
+	name   string
+
+	mu      sync.RWMutex
+	reasons map[string]struct{}
+	objects map[string]struct{}
+}
+
+func newSuppressionList(logger *zap.Logger, name string) *suppressionList {
+	return &suppressionList{logger: logger, name: name}
+}
+
+// start watches the ConfigMap in namespace until stopCh is closed.
+func (l *suppressionList) start(client k8s.Interface, namespace string, stopCh <-chan struct{}) {
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", l.name).String()
+		}))
+	_, _ = factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: l.onUpdate,
+		UpdateFunc: func(_, newObj any) {
+			l.onUpdate(newObj)
+		},
+		DeleteFunc: l.onDelete,
+	})
+	factory.Start(stopCh)
+}
+
+func (l *suppressionList) onUpdate(obj any) {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok || cm.Name != l.name {
+		return
+	}
+	l.update(cm.Data)
+}
+
+func (l *suppressionList) onDelete(obj any) {
+	cm, ok := unwrapTombstone(obj).(*corev1.ConfigMap)
+	if !ok || cm.Name != l.name {
+		return
+	}
+	// Nothing is suppressed once the ConfigMap is deleted.
+	l.update(nil)
+}
+
+// update replaces the suppressed reasons and objects with the ones listed in data.
+func (l *suppressionList) update(data map[string]string) {
+	reasons := suppressionEntries(data[suppressionKeyReasons])
+	objects := suppressionEntries(data[suppressionKeyObjects])
+	l.mu.Lock()
+	l.reasons = reasons
+	l.objects = objects
+	l.mu.Unlock()
+	l.logger.Info("updated the suppressed events",
+		zap.Int("reasons", len(reasons)), zap.Int("objects", len(objects)))
+}
+
+// suppressionEntries returns the entries of the list s, separated by whitespace.
+func suppressionEntries(s string) map[string]struct{} {
+	values := strings.Fields(s)
+	entries := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		entries[v] = struct{}{}
+	}
+	return entries
+}
+
+// suppresses reports whether the reason or the involved object of ev is suppressed.
+func (l *suppressionList) suppresses(ev *corev1.Event) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if _, ok := l.reasons[ev.Reason]; ok {
+		return true
+	}
+	if ev.InvolvedObject.Name == "" {
+		return false
+	}
+	if _, ok := l.objects[ev.InvolvedObject.Name]; ok {
+		return true
+	}
+	_, ok := l.objects[ev.InvolvedObject.Namespace+"/"+ev.InvolvedObject.Name]
+	return ok
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestSuppressionList(t *testing.T) {
+	l := newSuppressionList(zap.NewNop(), "suppressions")
+	assert.False(t, l.suppresses(getEvent()))
+
+	l.update(map[string]string{
+		suppressionKeyReasons: "BackOff\nUnhealthy\n",
+		suppressionKeyObjects: "web-1\n  payments/api\n",
+	})
+	tests := []struct {
+		name       string
+		reason     string
+		object     corev1.ObjectReference
+		suppressed bool
+	}{
+		{name: "reason", reason: "BackOff", object: corev1.ObjectReference{Name: "db-0", Namespace: "test"}, suppressed: true},
+		{name: "name", reason: "Started", object: corev1.ObjectReference{Name: "web-1", Namespace: "test"}, suppressed: true},
+		{name: "namespaced_name", reason: "Started", object: corev1.ObjectReference{Name: "api", Namespace: "payments"}, suppressed: true},
+		{name: "other_namespace", reason: "Started", object: corev1.ObjectReference{Name: "api", Namespace: "test"}},
+		{name: "other", reason: "Started", object: corev1.ObjectReference{Name: "db-0", Namespace: "test"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev := getEvent()
+			ev.Reason = tt.reason
+			ev.InvolvedObject = tt.object
+			assert.Equal(t, tt.suppressed, l.suppresses(ev))
+		})
+	}
+
+	l.update(nil)
+	ev := getEvent()
+	ev.Reason = "BackOff"
+	assert.False(t, l.suppresses(ev))
+}
+
+func TestSuppressionListOnDelete(t *testing.T) {
+	l := newSuppressionList(zap.NewNop(), "suppressions")
+	l.update(map[string]string{suppressionKeyReasons: "BackOff"})
+	ev := getEvent()
+	ev.Reason = "BackOff"
+
+	// The deletions of other objects leave the list unchanged.
+	l.onDelete(cache.DeletedFinalStateUnknown{Key: "test/other", Obj: &corev1.Secret{}})
+	assert.True(t, l.suppresses(ev))
+	l.onDelete(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	assert.True(t, l.suppresses(ev))
+
+	l.onDelete(cache.DeletedFinalStateUnknown{
+		Key: "test/suppressions",
+		Obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "suppressions"}},
+	})
+	assert.False(t, l.suppresses(ev))
+}
+
+func TestHandleEventWithSuppressionConfigMap(t *testing.T) {
+	client := fake.NewClientset()
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.SuppressionConfigMap = SuppressionConfigMapConfig{Namespace: "observability", Name: "suppressions"}
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	sink := new(consumertest.LogsSink)
+	recv := newTestReceiver(t, rCfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	// Nothing is suppressed until the ConfigMap is created.
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	cms := client.CoreV1().ConfigMaps("observability")
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "suppressions", Namespace: "observability"},
+		Data:       map[string]string{suppressionKeyReasons: getEvent().Reason},
+	}
+	_, err := cms.Create(context.Background(), cm, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return recv.suppressed(getEvent())
+	}, 5*time.Second, 10*time.Millisecond)
+	sink.Reset()
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 0, sink.LogRecordCount())
+	assert.Equal(t, int64(1), recv.stats.dropped[dropReasonSuppressionConfigMap])
+
+	// The other ConfigMaps of the namespace are ignored.
+	other := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "observability"},
+		Data:       map[string]string{suppressionKeyReasons: getEvent().Reason},
+	}
+	_, err = cms.Create(context.Background(), other, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	cm.Data = map[string]string{suppressionKeyObjects: "test/web-1"}
+	_, err = cms.Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return !recv.suppressed(getEvent())
+	}, 5*time.Second, 10*time.Millisecond)
+	recv.handleEvent(getEvent())
+	assert.Equal(t, 1, sink.LogRecordCount())
+	ev := getEvent()
+	ev.InvolvedObject.Name = "web-1"
+	assert.True(t, recv.suppressed(ev))
+
+	require.NoError(t, cms.Delete(context.Background(), cm.Name, metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		return !recv.suppressed(ev)
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// throttleIncreaseSteps is the number of consumptions within the target latency
+// for the rate to grow back from the minimum to the maximum.
+const throttleIncreaseSteps = 100
+
+// adaptiveThrottle limits the rate the events are emitted at, adjusting it to the latency of the
+// pipeline: the rate is halved when the events take longer than the target latency to be consumed,
+// and grows by a fraction of the maximum rate otherwise (AIMD).
+type adaptiveThrottle struct {
+	target  time.Duration
+	minRate float64
+	maxRate float64
+	limiter *rate.Limiter
+
+	mu   sync.Mutex
+	rate float64
+}
+
+func newAdaptiveThrottle(target time.Duration, minRate, maxRate float64) *adaptiveThrottle {
+	return &adaptiveThrottle{
+		target:  target,
+		minRate: minRate,
+		maxRate: maxRate,
+		limiter: rate.NewLimiter(rate.Limit(maxRate), throttleBurst(maxRate)),
+		rate:    maxRate,
+	}
+}
+
+// allow returns whether an event is emitted at now.
+func (t *adaptiveThrottle) allow(now time.Time) bool {
+	return t.limiter.AllowN(now, 1)
+}
+
+// observe adjusts the rate to the latency of the consumption of an event at now,
+// and returns the new rate.
+func (t *adaptiveThrottle) observe(latency time.Duration, now time.Time) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if latency > t.target {
+		t.rate = math.Max(t.minRate, t.rate/2)
+	} else {
+		t.rate = math.Min(t.maxRate, t.rate+(t.maxRate-t.minRate)/throttleIncreaseSteps)
+	}
+	t.limiter.SetLimitAt(now, rate.Limit(t.rate))
+	t.limiter.SetBurstAt(now, throttleBurst(t.rate))
+	return t.rate
+}
+
+// throttleBurst allows bursts of a second of events at r.
+func throttleBurst(r float64) int {
+	return max(1, int(math.Ceil(r)))
+}
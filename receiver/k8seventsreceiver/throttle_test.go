@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver/internal/metadatatest"
+)
+
+func TestAdaptiveThrottle(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	th := newAdaptiveThrottle(100*time.Millisecond, 10, 210)
+
+	// The rate is halved while the latency climbs, down to the minimum.
+	assert.InDelta(t, 105, th.observe(200*time.Millisecond, now), 1e-9)
+	assert.InDelta(t, 52.5, th.observe(300*time.Millisecond, now), 1e-9)
+	assert.InDelta(t, 26.25, th.observe(time.Second, now), 1e-9)
+	assert.InDelta(t, 13.125, th.observe(time.Second, now), 1e-9)
+	assert.InDelta(t, 10, th.observe(time.Second, now), 1e-9)
+	assert.InDelta(t, 10, th.observe(time.Second, now), 1e-9)
+
+	// The events beyond the rate are dropped.
+	later := now.Add(time.Minute)
+	allowed := 0
+	for i := 0; i < 20; i++ {
+		if th.allow(later) {
+			allowed++
+		}
+	}
+	assert.Equal(t, 10, allowed)
+	assert.True(t, th.allow(later.Add(100*time.Millisecond)))
+
+	// The rate grows back linearly while the latency is within the target, up to the maximum.
+	assert.InDelta(t, 12, th.observe(50*time.Millisecond, later), 1e-9)
+	assert.InDelta(t, 14, th.observe(100*time.Millisecond, later), 1e-9)
+	for i := 0; i < 200; i++ {
+		th.observe(time.Millisecond, later)
+	}
+	assert.InDelta(t, 210, th.observe(time.Millisecond, later), 1e-9)
+}
+
+func TestHandleEventWithAdaptiveThrottle(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.AdaptiveThrottle = AdaptiveThrottleConfig{
+		Enabled:       true,
+		TargetLatency: time.Millisecond,
+		MinRate:       1,
+		MaxRate:       4,
+	}
+	var consumed int
+	latency := 10 * time.Millisecond
+	next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		consumed++
+		time.Sleep(latency)
+		return nil
+	})
+	require.NoError(t, err)
+	r, err := newReceiver(metadatatest.NewSettings(tel), rCfg, next)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+	recv.ctx = context.Background()
+
+	// The slow pipeline makes the rate drop to the minimum, and the events beyond it are dropped.
+	for i := 0; i < 10; i++ {
+		recv.handleEvent(getEvent())
+	}
+	assert.Less(t, consumed, 10)
+	assert.Equal(t, map[string]int64{dropReasonAdaptiveThrottle: int64(10 - consumed)}, recv.stats.dropped)
+	metadatatest.AssertEqualK8seventsThrottleRate(t, tel, []metricdata.DataPoint[float64]{
+		{Value: 1},
+	}, metricdatatest.IgnoreTimestamp())
+}
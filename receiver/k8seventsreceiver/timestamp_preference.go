@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+)
+
+// timestampField returns the time of a field of an event, zero when unset.
+type timestampField func(ev *corev1.Event) time.Time
+
+func eventTimeField(ev *corev1.Event) time.Time { return ev.EventTime.Time }
+
+func lastTimestampField(ev *corev1.Event) time.Time { return ev.LastTimestamp.Time }
+
+func firstTimestampField(ev *corev1.Event) time.Time { return ev.FirstTimestamp.Time }
+
+func seriesLastObservedField(ev *corev1.Event) time.Time {
+	if ev.Series == nil {
+		return time.Time{}
+	}
+	return ev.Series.LastObservedTime.Time
+}
+
+// coreTimestampFields are the timestamp fields of the events of the `v1` API, by name.
+var coreTimestampFields = map[string]timestampField{
+	"eventTime":               eventTimeField,
+	"lastTimestamp":           lastTimestampField,
+	"firstTimestamp":          firstTimestampField,
+	"series.lastObservedTime": seriesLastObservedField,
+}
+
+// eventsAPITimestampFields are the timestamp fields of the events of the `events.k8s.io/v1` API,
+// by name, read from the fields of the `v1` API they are converted to.
+var eventsAPITimestampFields = map[string]timestampField{
+	"eventTime":                eventTimeField,
+	"deprecatedLastTimestamp":  lastTimestampField,
+	"deprecatedFirstTimestamp": firstTimestampField,
+	"series.lastObservedTime":  seriesLastObservedField,
+}
+
+// validateTimestampPreference checks that preference only lists the fields once and that they are known.
+func validateTimestampPreference(preference []string, fields map[string]timestampField) error {
+	for i, name := range preference {
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		if slices.Contains(preference[:i], name) {
+			return fmt.Errorf("duplicate field %q", name)
+		}
+	}
+	return nil
+}
+
+// logRecordTimestamp returns the timestamp of the log record of ev: the first field set among the
+// timestamp preference of the API the event was read from, falling back to the time of the event.
+func (cfg *Config) logRecordTimestamp(ev *corev1.Event) time.Time {
+	preference, fields := cfg.CoreTimestampPreference, coreTimestampFields
+	if ev.APIVersion == eventsv1.SchemeGroupVersion.String() {
+		preference, fields = cfg.EventsAPITimestampPreference, eventsAPITimestampFields
+	}
+	for _, name := range preference {
+		if t := fields[name](ev); !t.IsZero() {
+			return t
+		}
+	}
+	return getEventTimestamp(ev)
+}